@@ -1,7 +1,9 @@
 package librenms
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -60,7 +62,12 @@ type (
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#ack_alert
 func (c *Client) AckAlert(alertID int, payload *AlertAckRequest) (*BaseResponse, error) {
-	req, err := c.newRequest(http.MethodPut, fmt.Sprintf("%s/%d", alertEndpoint, alertID), payload, nil)
+	return c.AckAlertWithContext(context.Background(), alertID, payload)
+}
+
+// AckAlertWithContext is like AckAlert, but uses the provided context for the request.
+func (c *Client) AckAlertWithContext(ctx context.Context, alertID int, payload *AlertAckRequest) (*BaseResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodPut, fmt.Sprintf("%s/%d", alertEndpoint, alertID), payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -69,11 +76,27 @@ func (c *Client) AckAlert(alertID int, payload *AlertAckRequest) (*BaseResponse,
 	return alertsResp, c.do(req, alertsResp)
 }
 
+// Alerts returns an iterator over the alerts matching the query. Unlike GetAlerts, the
+// response is decoded one alert at a time.
+//
+// Documentation: https://docs.librenms.org/API/Alerts/#list_alerts
+func (c *Client) Alerts(ctx context.Context, query *AlertsQuery) iter.Seq2[Alert, error] {
+	if query == nil {
+		query = NewAlertsQuery()
+	}
+	return listSeq[Alert](ctx, c, alertEndpoint, query.values(), listConfig{key: "alerts"})
+}
+
 // GetAlert retrieves a specific alert by its ID from the LibreNMS API.
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#get_alert
 func (c *Client) GetAlert(alertID int) (*AlertsResponse, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf("%s/%d", alertEndpoint, alertID), nil, nil)
+	return c.GetAlertWithContext(context.Background(), alertID)
+}
+
+// GetAlertWithContext is like GetAlert, but uses the provided context for the request.
+func (c *Client) GetAlertWithContext(ctx context.Context, alertID int) (*AlertsResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d", alertEndpoint, alertID), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -86,10 +109,15 @@ func (c *Client) GetAlert(alertID int) (*AlertsResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#list_alerts
 func (c *Client) GetAlerts(query *AlertsQuery) (*AlertsResponse, error) {
+	return c.GetAlertsWithContext(context.Background(), query)
+}
+
+// GetAlertsWithContext is like GetAlerts, but uses the provided context for the request.
+func (c *Client) GetAlertsWithContext(ctx context.Context, query *AlertsQuery) (*AlertsResponse, error) {
 	if query == nil {
 		query = NewAlertsQuery()
 	}
-	req, err := c.newRequest(http.MethodGet, alertEndpoint, nil, query.values())
+	req, err := c.newRequest(ctx, http.MethodGet, alertEndpoint, nil, query.values())
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +130,12 @@ func (c *Client) GetAlerts(query *AlertsQuery) (*AlertsResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#unmute_alert
 func (c *Client) UnmuteAlert(alertID int) (*BaseResponse, error) {
-	req, err := c.newRequest(http.MethodPut, fmt.Sprintf("%s/unmute/%d", alertEndpoint, alertID), nil, nil)
+	return c.UnmuteAlertWithContext(context.Background(), alertID)
+}
+
+// UnmuteAlertWithContext is like UnmuteAlert, but uses the provided context for the request.
+func (c *Client) UnmuteAlertWithContext(ctx context.Context, alertID int) (*BaseResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodPut, fmt.Sprintf("%s/unmute/%d", alertEndpoint, alertID), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,9 @@
 package librenms
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -64,16 +66,29 @@ type (
 	}
 )
 
+// AlertRules returns an iterator over all alert rules. Unlike GetAlertRules, the
+// response is decoded one rule at a time.
+//
+// Documentation: https://docs.librenms.org/API/Alerts/#list_alert_rules
+func (c *Client) AlertRules(ctx context.Context) iter.Seq2[AlertRule, error] {
+	return listSeq[AlertRule](ctx, c, alertRuleEndpoint, nil, listConfig{key: "rules"})
+}
+
 // CreateAlertRule creates a specific alert rule in the LibreNMS API.
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#add_rule
 func (c *Client) CreateAlertRule(payload *AlertRuleCreateRequest) (*BaseResponse, error) {
+	return c.CreateAlertRuleWithContext(context.Background(), payload)
+}
+
+// CreateAlertRuleWithContext is like CreateAlertRule, but uses the provided context for the request.
+func (c *Client) CreateAlertRuleWithContext(ctx context.Context, payload *AlertRuleCreateRequest) (*BaseResponse, error) {
 	// as a convenience/hack, add a -1 to Devices if Devices is empty
 	if len(payload.Devices) == 0 {
 		payload.Devices = []int{-1}
 	}
 
-	req, err := c.newRequest(http.MethodPost, alertRuleEndpoint, payload, nil)
+	req, err := c.newRequest(ctx, http.MethodPost, alertRuleEndpoint, payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +100,12 @@ func (c *Client) CreateAlertRule(payload *AlertRuleCreateRequest) (*BaseResponse
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#delete_rule
 func (c *Client) DeleteAlertRule(id int) (*BaseResponse, error) {
-	req, err := c.newRequest(http.MethodDelete, fmt.Sprintf("%s/%d", alertRuleEndpoint, id), nil, nil)
+	return c.DeleteAlertRuleWithContext(context.Background(), id)
+}
+
+// DeleteAlertRuleWithContext is like DeleteAlertRule, but uses the provided context for the request.
+func (c *Client) DeleteAlertRuleWithContext(ctx context.Context, id int) (*BaseResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", alertRuleEndpoint, id), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +117,12 @@ func (c *Client) DeleteAlertRule(id int) (*BaseResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#get_alert_rule
 func (c *Client) GetAlertRule(id int) (*AlertRuleResponse, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf("%s/%d", alertRuleEndpoint, id), nil, nil)
+	return c.GetAlertRuleWithContext(context.Background(), id)
+}
+
+// GetAlertRuleWithContext is like GetAlertRule, but uses the provided context for the request.
+func (c *Client) GetAlertRuleWithContext(ctx context.Context, id int) (*AlertRuleResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d", alertRuleEndpoint, id), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +134,12 @@ func (c *Client) GetAlertRule(id int) (*AlertRuleResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#list_alert_rules
 func (c *Client) GetAlertRules() (*AlertRuleResponse, error) {
-	req, err := c.newRequest(http.MethodGet, alertRuleEndpoint, nil, nil)
+	return c.GetAlertRulesWithContext(context.Background())
+}
+
+// GetAlertRulesWithContext is like GetAlertRules, but uses the provided context for the request.
+func (c *Client) GetAlertRulesWithContext(ctx context.Context) (*AlertRuleResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, alertRuleEndpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +151,11 @@ func (c *Client) GetAlertRules() (*AlertRuleResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Alerts/#edit_rule
 func (c *Client) UpdateAlertRule(payload *AlertRuleUpdateRequest) (*BaseResponse, error) {
+	return c.UpdateAlertRuleWithContext(context.Background(), payload)
+}
+
+// UpdateAlertRuleWithContext is like UpdateAlertRule, but uses the provided context for the request.
+func (c *Client) UpdateAlertRuleWithContext(ctx context.Context, payload *AlertRuleUpdateRequest) (*BaseResponse, error) {
 	if payload.ID < 1 {
 		return nil, fmt.Errorf("rule ID is required for updating an alert rule")
 	}
@@ -130,7 +165,7 @@ func (c *Client) UpdateAlertRule(payload *AlertRuleUpdateRequest) (*BaseResponse
 		payload.Devices = []int{-1}
 	}
 
-	req, err := c.newRequest(http.MethodPut, alertRuleEndpoint, payload, nil)
+	req, err := c.newRequest(ctx, http.MethodPut, alertRuleEndpoint, payload, nil)
 	if err != nil {
 		return nil, err
 	}
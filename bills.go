@@ -0,0 +1,552 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+)
+
+const (
+	// billEndpoint is the API endpoint for bills.
+	billEndpoint = "bills"
+
+	// BillTypeCDR is a bill with a committed data rate, billed on the 95th percentile.
+	BillTypeCDR = "cdr"
+	// BillTypeQuota is a bill with a data transfer quota.
+	BillTypeQuota = "quota"
+
+	// BillDirectionIn bills the 95th percentile of inbound traffic.
+	BillDirectionIn = "in"
+	// BillDirectionOut bills the 95th percentile of outbound traffic.
+	BillDirectionOut = "out"
+	// BillDirectionMax bills the higher of the inbound and outbound 95th percentiles.
+	BillDirectionMax = "max"
+	// BillDirectionAgg bills the 95th percentile of the combined inbound and outbound traffic.
+	BillDirectionAgg = "agg"
+
+	// BillPeriodPrevious selects the previous billing period in BillsQuery.
+	BillPeriodPrevious = "previous"
+)
+
+type (
+	// Bill represents a bill in LibreNMS.
+	//
+	// Rates are in bits per second, and data totals and quotas are in bytes.
+	Bill struct {
+		ID int `json:"bill_id"`
+
+		AutoAdded      Bool       `json:"bill_autoadded"`
+		CDR            *Float64   `json:"bill_cdr"` // committed data rate, for cdr bills
+		CustID         string     `json:"bill_custid"`
+		Day            int        `json:"bill_day"` // day of the month the billing period starts
+		Dir95th        string     `json:"dir_95th"` // in, out, max, agg
+		LastCalc       Time       `json:"bill_last_calc"`
+		Name           string     `json:"bill_name"`
+		Notes          string     `json:"bill_notes"`
+		Percent        *Float64   `json:"percent"` // percentage of the CDR or quota used
+		Ports          []BillPort `json:"ports"`
+		Quota          *Float64   `json:"bill_quota"` // for quota bills
+		Rate95th       Float64    `json:"rate_95th"`
+		Rate95thIn     Float64    `json:"rate_95th_in"`
+		Rate95thOut    Float64    `json:"rate_95th_out"`
+		RateAverage    Float64    `json:"rate_average"`
+		RateAverageIn  Float64    `json:"rate_average_in"`
+		RateAverageOut Float64    `json:"rate_average_out"`
+		Ref            string     `json:"bill_ref"`
+		TotalData      Float64    `json:"total_data"`
+		TotalDataIn    Float64    `json:"total_data_in"`
+		TotalDataOut   Float64    `json:"total_data_out"`
+		Type           string     `json:"bill_type"` // cdr, quota
+	}
+
+	// BillPort represents a port assigned to a bill.
+	BillPort struct {
+		DeviceID int     `json:"device_id"`
+		PortID   int     `json:"port_id"`
+		Hostname *string `json:"hostname"`
+		IfName   *string `json:"ifName"`
+	}
+
+	// BillResponse represents a response containing a list of bills.
+	BillResponse struct {
+		BaseResponse
+		Bills []Bill `json:"bills"`
+	}
+
+	// BillsQuery represents the query parameters for filtering GetBills() and GetBill().
+	BillsQuery struct {
+		CustID string `url:"custid,omitempty"`
+		Period string `url:"period,omitempty"` // "previous" for the previous billing period
+		Ref    string `url:"ref,omitempty"`
+	}
+
+	// BillHistory represents a completed billing period of a bill.
+	BillHistory struct {
+		ID     int `json:"bill_hist_id"`
+		BillID int `json:"bill_id"`
+
+		Allowed        Float64 `json:"bill_allowed"` // CDR in bits/s, or quota in bytes
+		DateFrom       Time    `json:"bill_datefrom"`
+		DateTo         Time    `json:"bill_dateto"`
+		Dir95th        string  `json:"dir_95th"`
+		Overuse        Float64 `json:"bill_overuse"`
+		Percent        Float64 `json:"bill_percent"`
+		Rate95th       Float64 `json:"rate_95th"`
+		Rate95thIn     Float64 `json:"rate_95th_in"`
+		Rate95thOut    Float64 `json:"rate_95th_out"`
+		RateAverage    Float64 `json:"rate_average"`
+		RateAverageIn  Float64 `json:"rate_average_in"`
+		RateAverageOut Float64 `json:"rate_average_out"`
+		TrafficIn      Float64 `json:"traf_in"`
+		TrafficOut     Float64 `json:"traf_out"`
+		TrafficTotal   Float64 `json:"traf_total"`
+		Type           string  `json:"bill_type"`
+		Updated        Time    `json:"updated"`
+		Used           Float64 `json:"bill_used"` // 95th percentile in bits/s, or transferred bytes
+	}
+
+	// BillHistoryResponse represents a response containing the history of a bill.
+	BillHistoryResponse struct {
+		BaseResponse
+		History []BillHistory `json:"bill_history"`
+	}
+
+	// BillGraphData represents the data behind a bill graph. For "bits" graphs the
+	// samples are rates in bits per second, one per polling interval.
+	BillGraphData struct {
+		From        Time      `json:"from"`
+		To          Time      `json:"to"`
+		InData      []Float64 `json:"in_data"`
+		OutData     []Float64 `json:"out_data"`
+		TotalData   []Float64 `json:"tot_data"`
+		Rate95th    *Float64  `json:"rate_95th"`
+		RateAverage *Float64  `json:"rate_average"`
+	}
+
+	// BillGraphDataResponse represents a response containing bill graph data.
+	BillGraphDataResponse struct {
+		BaseResponse
+		GraphData BillGraphData `json:"graph_data"`
+	}
+
+	// BillRequest represents the request payload for creating or updating a bill.
+	//
+	// When creating a bill, Name and Type are required, along with CDR for cdr bills
+	// or Quota for quota bills. When updating a bill, only the set fields are changed.
+	// Ports replaces the ports assigned to the bill.
+	BillRequest struct {
+		ID      *int   `json:"bill_id,omitempty"`
+		CDR     *int64 `json:"bill_cdr,omitempty"`
+		CustID  string `json:"bill_custid,omitempty"`
+		Day     *int   `json:"bill_day,omitempty"`
+		Dir95th string `json:"dir_95th,omitempty"`
+		Name    string `json:"bill_name,omitempty"`
+		Notes   string `json:"bill_notes,omitempty"`
+		Ports   []int  `json:"ports,omitempty"`
+		Quota   *int64 `json:"bill_quota,omitempty"`
+		Ref     string `json:"bill_ref,omitempty"`
+		Type    string `json:"bill_type,omitempty"`
+	}
+
+	// BillCreateResponse represents the response to creating or updating a bill.
+	BillCreateResponse struct {
+		BaseResponse
+		BillID int `json:"bill_id"`
+	}
+
+	// BillUsage represents the usage of a bill for a billing period, as calculated by
+	// CalculateCDRUsage() or CalculateQuotaUsage().
+	BillUsage struct {
+		// Rate95thIn and Rate95thOut are the 95th percentile rates in bits/s (cdr bills only).
+		Rate95thIn  float64
+		Rate95thOut float64
+		// Used is the billed 95th percentile rate in bits/s, or the transferred bytes.
+		Used float64
+		// Allowed is the committed data rate in bits/s, or the quota in bytes.
+		Allowed float64
+		// Overage is the amount Used exceeds Allowed by, or 0.
+		Overage float64
+		// Percent is Used as a percentage of Allowed, or 0 if nothing is allowed.
+		Percent float64
+	}
+)
+
+// CreateBill creates a new bill.
+//
+// Documentation: https://docs.librenms.org/API/Bills/#create_edit_bill
+func (c *Client) CreateBill(payload *BillRequest) (*BillCreateResponse, error) {
+	return c.CreateBillWithContext(context.Background(), payload)
+}
+
+// CreateBillWithContext is like CreateBill, but uses the provided context for the request.
+func (c *Client) CreateBillWithContext(ctx context.Context, payload *BillRequest) (*BillCreateResponse, error) {
+	if payload == nil {
+		return nil, errors.New("bill request is required")
+	}
+	if payload.ID != nil {
+		return nil, errors.New("bill ID must not be set when creating a bill, use UpdateBill()")
+	}
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	return c.createEditBill(ctx, payload)
+}
+
+// DeleteBill deletes a bill by its ID.
+//
+// Documentation: https://docs.librenms.org/API/Bills/#delete_bill
+func (c *Client) DeleteBill(billID int) (*BaseResponse, error) {
+	return c.DeleteBillWithContext(context.Background(), billID)
+}
+
+// DeleteBillWithContext is like DeleteBill, but uses the provided context for the request.
+func (c *Client) DeleteBillWithContext(ctx context.Context, billID int) (*BaseResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", billEndpoint, billID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetBill retrieves a bill by its ID. Set query.Period to BillPeriodPrevious to
+// retrieve the figures of the previous billing period; the other query fields are ignored.
+//
+// Documentation: https://docs.librenms.org/API/Bills/#get_bill
+func (c *Client) GetBill(billID int, query *BillsQuery) (*BillResponse, error) {
+	return c.GetBillWithContext(context.Background(), billID, query)
+}
+
+// GetBillWithContext is like GetBill, but uses the provided context for the request.
+func (c *Client) GetBillWithContext(ctx context.Context, billID int, query *BillsQuery) (*BillResponse, error) {
+	var params BillsQuery
+	if query != nil {
+		params.Period = query.Period
+	}
+	return c.getBills(ctx, fmt.Sprintf("%s/%d", billEndpoint, billID), &params)
+}
+
+// GetBillGraphData retrieves the data behind a graph of the current billing period of a bill.
+// The graph type is "bits" or "transfer".
+//
+// Documentation: https://docs.librenms.org/API/Bills/#get_bill_graphdata
+func (c *Client) GetBillGraphData(billID int, graphType string) (*BillGraphDataResponse, error) {
+	return c.GetBillGraphDataWithContext(context.Background(), billID, graphType)
+}
+
+// GetBillGraphDataWithContext is like GetBillGraphData, but uses the provided context for the request.
+func (c *Client) GetBillGraphDataWithContext(ctx context.Context, billID int, graphType string) (*BillGraphDataResponse, error) {
+	if graphType == "" {
+		return nil, errors.New("graph type is required")
+	}
+	return c.getBillGraphData(ctx, fmt.Sprintf("%s/%d/graphdata/%s", billEndpoint, billID, graphType))
+}
+
+// GetBillHistory retrieves the completed billing periods of a bill.
+//
+// Documentation: https://docs.librenms.org/API/Bills/#get_bill_history
+func (c *Client) GetBillHistory(billID int) (*BillHistoryResponse, error) {
+	return c.GetBillHistoryWithContext(context.Background(), billID)
+}
+
+// GetBillHistoryWithContext is like GetBillHistory, but uses the provided context for the request.
+func (c *Client) GetBillHistoryWithContext(ctx context.Context, billID int) (*BillHistoryResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d/history", billEndpoint, billID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BillHistoryResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetBillHistoryGraphData retrieves the data behind a graph of a completed billing period
+// of a bill. The graph type is "bits" or "transfer".
+//
+// Documentation: https://docs.librenms.org/API/Bills/#get_bill_history_graphdata
+func (c *Client) GetBillHistoryGraphData(billID, historyID int, graphType string) (*BillGraphDataResponse, error) {
+	return c.GetBillHistoryGraphDataWithContext(context.Background(), billID, historyID, graphType)
+}
+
+// GetBillHistoryGraphDataWithContext is like GetBillHistoryGraphData, but uses the provided context for the request.
+func (c *Client) GetBillHistoryGraphDataWithContext(ctx context.Context, billID, historyID int, graphType string) (*BillGraphDataResponse, error) {
+	if graphType == "" {
+		return nil, errors.New("graph type is required")
+	}
+	return c.getBillGraphData(ctx, fmt.Sprintf("%s/%d/history/%d/graphdata/%s", billEndpoint, billID, historyID, graphType))
+}
+
+// GetBills retrieves a list of bills, optionally filtered by reference or customer ID.
+//
+// Documentation: https://docs.librenms.org/API/Bills/#list_bills
+func (c *Client) GetBills(query *BillsQuery) (*BillResponse, error) {
+	return c.GetBillsWithContext(context.Background(), query)
+}
+
+// GetBillsWithContext is like GetBills, but uses the provided context for the request.
+func (c *Client) GetBillsWithContext(ctx context.Context, query *BillsQuery) (*BillResponse, error) {
+	return c.getBills(ctx, billEndpoint, query)
+}
+
+// GetBillsByPort retrieves the bills a port is assigned to. The API has no port filter,
+// so all bills are retrieved and filtered by their assigned ports.
+func (c *Client) GetBillsByPort(portID int) (*BillResponse, error) {
+	return c.GetBillsByPortWithContext(context.Background(), portID)
+}
+
+// GetBillsByPortWithContext is like GetBillsByPort, but uses the provided context for the request.
+func (c *Client) GetBillsByPortWithContext(ctx context.Context, portID int) (*BillResponse, error) {
+	resp, err := c.getBills(ctx, billEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Bills = slices.DeleteFunc(resp.Bills, func(bill Bill) bool {
+		return !slices.ContainsFunc(bill.Ports, func(port BillPort) bool {
+			return port.PortID == portID
+		})
+	})
+	resp.Count = len(resp.Bills)
+	return resp, nil
+}
+
+// UpdateBill updates an existing bill by its ID. Only the fields set in the payload are changed.
+//
+// Documentation: https://docs.librenms.org/API/Bills/#create_edit_bill
+func (c *Client) UpdateBill(billID int, payload *BillRequest) (*BillCreateResponse, error) {
+	return c.UpdateBillWithContext(context.Background(), billID, payload)
+}
+
+// UpdateBillWithContext is like UpdateBill, but uses the provided context for the request.
+func (c *Client) UpdateBillWithContext(ctx context.Context, billID int, payload *BillRequest) (*BillCreateResponse, error) {
+	if payload == nil {
+		return nil, errors.New("bill request is required")
+	}
+
+	update := *payload
+	update.ID = &billID
+	if err := update.Validate(); err != nil {
+		return nil, err
+	}
+	return c.createEditBill(ctx, &update)
+}
+
+// createEditBill sends a create_edit_bill request, which updates a bill if the payload has an ID.
+func (c *Client) createEditBill(ctx context.Context, payload *BillRequest) (*BillCreateResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodPost, billEndpoint, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BillCreateResponse)
+	return resp, c.do(req, resp)
+}
+
+// getBillGraphData retrieves bill graph data from the given URI.
+func (c *Client) getBillGraphData(ctx context.Context, uri string) (*BillGraphDataResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BillGraphDataResponse)
+	return resp, c.do(req, resp)
+}
+
+// getBills retrieves bills from the given URI.
+func (c *Client) getBills(ctx context.Context, uri string, query *BillsQuery) (*BillResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BillResponse)
+	return resp, c.do(req, resp)
+}
+
+// CalculateCDRUsage calculates the usage of a cdr bill from per-interval rate samples in
+// bits/s (e.g. BillGraphData.InData and OutData of a "bits" graph), the billing direction
+// and the committed data rate in bits/s.
+//
+// The 95th percentile is calculated the way LibreNMS does: the samples are sorted and
+// the top 5% are discarded. For BillDirectionAgg, the inbound and outbound samples are
+// summed pairwise before the percentile is taken.
+func CalculateCDRUsage(in, out []float64, direction string, committedRate float64) BillUsage {
+	usage := BillUsage{
+		Rate95thIn:  percentile95(in),
+		Rate95thOut: percentile95(out),
+		Allowed:     committedRate,
+	}
+
+	switch direction {
+	case BillDirectionIn:
+		usage.Used = usage.Rate95thIn
+	case BillDirectionOut:
+		usage.Used = usage.Rate95thOut
+	case BillDirectionAgg:
+		total := make([]float64, max(len(in), len(out)))
+		for i := range total {
+			if i < len(in) {
+				total[i] += in[i]
+			}
+			if i < len(out) {
+				total[i] += out[i]
+			}
+		}
+		usage.Used = percentile95(total)
+	default:
+		usage.Used = math.Max(usage.Rate95thIn, usage.Rate95thOut)
+	}
+
+	usage.calculateOverage()
+	return usage
+}
+
+// CalculateQuotaUsage calculates the usage of a quota bill from the transferred bytes
+// and the quota in bytes.
+func CalculateQuotaUsage(bytesIn, bytesOut, quota float64) BillUsage {
+	usage := BillUsage{
+		Used:    bytesIn + bytesOut,
+		Allowed: quota,
+	}
+	usage.calculateOverage()
+	return usage
+}
+
+// percentile95 returns the 95th percentile of the samples, or 0 if there are none.
+func percentile95(samples []float64) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+
+	idx := int(math.Round(float64(len(sorted))*0.95)) - 1
+	return sorted[max(idx, 0)]
+}
+
+// CDRUsage calculates the usage of a cdr bill from the samples of a "bits" graph.
+// See CalculateCDRUsage().
+func (d *BillGraphData) CDRUsage(direction string, committedRate float64) BillUsage {
+	toFloats := func(values []Float64) []float64 {
+		floats := make([]float64, len(values))
+		for i, v := range values {
+			floats[i] = float64(v)
+		}
+		return floats
+	}
+	return CalculateCDRUsage(toFloats(d.InData), toFloats(d.OutData), direction, committedRate)
+}
+
+// NewBillRequest creates a new BillRequest with the given name and type.
+func NewBillRequest(name, billType string) *BillRequest {
+	return &BillRequest{Name: name, Type: billType}
+}
+
+// SetCDR sets the committed data rate of the bill in bits/s.
+func (r *BillRequest) SetCDR(rate int64) *BillRequest {
+	r.CDR = &rate
+	return r
+}
+
+// SetCustID sets the customer ID of the bill.
+func (r *BillRequest) SetCustID(custID string) *BillRequest {
+	r.CustID = custID
+	return r
+}
+
+// SetDay sets the day of the month the billing period starts.
+func (r *BillRequest) SetDay(day int) *BillRequest {
+	r.Day = &day
+	return r
+}
+
+// SetDir95th sets the billing direction, one of the BillDirection constants.
+func (r *BillRequest) SetDir95th(direction string) *BillRequest {
+	r.Dir95th = direction
+	return r
+}
+
+// SetNotes sets the notes of the bill.
+func (r *BillRequest) SetNotes(notes string) *BillRequest {
+	r.Notes = notes
+	return r
+}
+
+// SetPorts sets the IDs of the ports assigned to the bill.
+func (r *BillRequest) SetPorts(portIDs ...int) *BillRequest {
+	r.Ports = portIDs
+	return r
+}
+
+// SetQuota sets the data transfer quota of the bill in bytes.
+func (r *BillRequest) SetQuota(quota int64) *BillRequest {
+	r.Quota = &quota
+	return r
+}
+
+// SetRef sets the reference of the bill.
+func (r *BillRequest) SetRef(ref string) *BillRequest {
+	r.Ref = ref
+	return r
+}
+
+// Validate checks the request's field values, and that a new bill (without an ID)
+// has a name, a type, and the CDR or quota its type requires.
+func (r *BillRequest) Validate() error {
+	if r.Type != "" && r.Type != BillTypeCDR && r.Type != BillTypeQuota {
+		return fmt.Errorf("invalid bill type %q, expected %q or %q", r.Type, BillTypeCDR, BillTypeQuota)
+	}
+	if r.Day != nil && (*r.Day < 1 || *r.Day > 31) {
+		return fmt.Errorf("invalid bill day %d, expected 1-31", *r.Day)
+	}
+	switch r.Dir95th {
+	case "", BillDirectionIn, BillDirectionOut, BillDirectionMax, BillDirectionAgg:
+	default:
+		return fmt.Errorf("invalid bill direction %q", r.Dir95th)
+	}
+	if r.CDR != nil && *r.CDR <= 0 {
+		return errors.New("bill CDR must be positive")
+	}
+	if r.Quota != nil && *r.Quota <= 0 {
+		return errors.New("bill quota must be positive")
+	}
+
+	if r.ID != nil {
+		return nil
+	}
+	if r.Name == "" {
+		return errors.New("bill name is required")
+	}
+	switch r.Type {
+	case BillTypeCDR:
+		if r.CDR == nil {
+			return errors.New("bill CDR is required for cdr bills")
+		}
+	case BillTypeQuota:
+		if r.Quota == nil {
+			return errors.New("bill quota is required for quota bills")
+		}
+	default:
+		return errors.New("bill type is required")
+	}
+	return nil
+}
+
+// calculateOverage sets the overage and percentage from the used and allowed amounts.
+func (u *BillUsage) calculateOverage() {
+	u.Overage = math.Max(u.Used-u.Allowed, 0)
+	if u.Allowed > 0 {
+		u.Percent = u.Used / u.Allowed * 100
+	}
+}
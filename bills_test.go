@@ -0,0 +1,250 @@
+package librenms_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointBill                 = "/api/v0/bills/1"
+	testEndpointBillGraphData        = "/api/v0/bills/1/graphdata/bits"
+	testEndpointBillHistory          = "/api/v0/bills/1/history"
+	testEndpointBillHistoryGraphData = "/api/v0/bills/1/history/10/graphdata/bits"
+	testEndpointBills                = "/api/v0/bills"
+)
+
+// This init function will register handlers for bill API endpoints.
+func init() {
+	// Registering this endpoint outside of handleEndpoint() to verify the query parameters and payloads.
+	mux.HandleFunc(testEndpointBills, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("ref") == "INV-A" {
+				_, err = w.Write(loadMockResponse("get_bill_200.json"))
+			} else {
+				_, err = w.Write(loadMockResponse("get_bills_200.json"))
+			}
+		case http.MethodPost:
+			var payload map[string]any
+			if json.NewDecoder(r.Body).Decode(&payload) != nil || payload["bill_name"] == nil && payload["bill_id"] == nil {
+				http.Error(w, `{"status": "error", "message": "unexpected payload"}`, http.StatusBadRequest)
+				return
+			}
+			if id, ok := payload["bill_id"]; ok && id != float64(1) {
+				http.Error(w, `{"status": "error", "message": "unexpected bill ID"}`, http.StatusBadRequest)
+				return
+			}
+			_, err = w.Write(loadMockResponse("create_bill_200.json"))
+		default:
+			notImplemented(testEndpointBills, w, r)
+			return
+		}
+		handleWriteErr(err, w)
+	})
+
+	// Registering this endpoint outside of handleEndpoint() to verify the query parameters.
+	mux.HandleFunc(testEndpointBill, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			if q := r.URL.Query(); q.Has("ref") || q.Has("custid") {
+				http.Error(w, `{"status": "error", "message": "unexpected query"}`, http.StatusBadRequest)
+				return
+			}
+			_, err = w.Write(loadMockResponse("get_bill_200.json"))
+		case http.MethodDelete:
+			_, err = w.Write(loadMockResponse("delete_bill_200.json"))
+		default:
+			notImplemented(testEndpointBill, w, r)
+			return
+		}
+		handleWriteErr(err, w)
+	})
+
+	handleEndpoint(testEndpointBillGraphData, mockResponses{
+		http.MethodGet: loadMockResponse("get_bill_graphdata_200.json"),
+	})
+
+	handleEndpoint(testEndpointBillHistory, mockResponses{
+		http.MethodGet: loadMockResponse("get_bill_history_200.json"),
+	})
+
+	handleEndpoint(testEndpointBillHistoryGraphData, mockResponses{
+		http.MethodGet: loadMockResponse("get_bill_graphdata_200.json"),
+	})
+}
+
+func TestClient_GetBills(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetBills(nil)
+
+	r.NoError(err, "GetBills returned an error")
+	r.NotNil(resp, "GetBills response is nil")
+	r.Len(resp.Bills, 2, "Expected 2 bills")
+
+	bill := resp.Bills[0]
+	r.Equal(1, bill.ID, "Unexpected bill ID")
+	r.Equal(librenms.BillTypeCDR, bill.Type, "Unexpected bill type")
+	r.Equal(100000000.0, float64(*bill.CDR), "Unexpected CDR")
+	r.Nil(bill.Quota, "Expected nil quota")
+	r.Equal(45000000.0, float64(bill.Rate95thIn), "Expected 95th percentile parsed from a string")
+	r.Len(bill.Ports, 1, "Expected 1 port")
+	r.Equal(time.Date(2025, 6, 1, 22, 0, 0, 0, time.UTC), bill.LastCalc.Time, "Unexpected last calculation")
+	r.Equal(2000000000000.0, float64(*resp.Bills[1].Quota), "Expected quota parsed from a string")
+
+	resp, err = testAPIClient.GetBills(&librenms.BillsQuery{Ref: "INV-A"})
+	r.NoError(err, "GetBills returned an error for a reference")
+	r.Len(resp.Bills, 1, "Expected 1 bill")
+}
+
+func TestClient_GetBill(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	// only the period applies to a bill by ID
+	resp, err := testAPIClient.GetBill(1, &librenms.BillsQuery{Period: librenms.BillPeriodPrevious, Ref: "ignored"})
+
+	r.NoError(err, "GetBill returned an error")
+	r.Len(resp.Bills, 1, "Expected 1 bill")
+	r.Equal("Customer A transit", resp.Bills[0].Name, "Unexpected bill name")
+
+	resp, err = testAPIClient.GetBillsByPort(4)
+	r.NoError(err, "GetBillsByPort returned an error")
+	r.Equal(1, resp.Count, "Expected count 1")
+	r.Equal(2, resp.Bills[0].ID, "Expected the bill assigned to port 4")
+}
+
+func TestClient_GetBillHistory(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetBillHistory(1)
+
+	r.NoError(err, "GetBillHistory returned an error")
+	r.Len(resp.History, 1, "Expected 1 history entry")
+
+	history := resp.History[0]
+	r.Equal(10, history.ID, "Unexpected history ID")
+	r.Equal(time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), history.DateFrom.Time, "Unexpected start date")
+	r.Equal(10000000.0, float64(history.Overuse), "Unexpected overuse")
+
+	graph, err := testAPIClient.GetBillHistoryGraphData(1, 10, "bits")
+	r.NoError(err, "GetBillHistoryGraphData returned an error")
+	r.Len(graph.GraphData.InData, 20, "Expected 20 inbound samples")
+
+	_, err = testAPIClient.GetBillHistoryGraphData(1, 10, "")
+	r.Error(err, "Expected error for empty graph type")
+}
+
+func TestClient_GetBillGraphData_Usage(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetBillGraphData(1, "bits")
+
+	r.NoError(err, "GetBillGraphData returned an error")
+	r.Equal(time.Unix(1746057600, 0).UTC(), resp.GraphData.From.Time, "Unexpected from time")
+	r.Equal(38000000.0, float64(*resp.GraphData.Rate95th), "Unexpected 95th percentile")
+
+	// inbound samples are 1-20 Mbps, outbound 40-2 Mbps
+	usage := resp.GraphData.CDRUsage(librenms.BillDirectionMax, 30000000)
+	r.Equal(19000000.0, usage.Rate95thIn, "Unexpected inbound 95th percentile")
+	r.Equal(38000000.0, usage.Rate95thOut, "Unexpected outbound 95th percentile")
+	r.Equal(38000000.0, usage.Used, "Expected the higher 95th percentile")
+	r.Equal(8000000.0, usage.Overage, "Unexpected overage")
+	r.InDelta(126.67, usage.Percent, 0.01, "Unexpected percentage")
+
+	usage = resp.GraphData.CDRUsage(librenms.BillDirectionIn, 30000000)
+	r.Equal(19000000.0, usage.Used, "Expected the inbound 95th percentile")
+	r.Zero(usage.Overage, "Expected no overage")
+
+	// aggregate samples are 41-22 Mbps
+	usage = resp.GraphData.CDRUsage(librenms.BillDirectionAgg, 30000000)
+	r.Equal(40000000.0, usage.Used, "Expected the aggregate 95th percentile")
+}
+
+func TestCalculateUsage(t *testing.T) {
+	r := require.New(t)
+
+	usage := librenms.CalculateCDRUsage(nil, nil, librenms.BillDirectionMax, 100)
+	r.Zero(usage.Used, "Expected no usage without samples")
+	r.Zero(usage.Percent, "Expected 0 percent without samples")
+
+	usage = librenms.CalculateCDRUsage([]float64{5}, nil, librenms.BillDirectionIn, 0)
+	r.Equal(5.0, usage.Used, "Expected the single sample")
+	r.Equal(5.0, usage.Overage, "Expected all usage as overage")
+	r.Zero(usage.Percent, "Expected 0 percent when nothing is allowed")
+
+	usage = librenms.CalculateQuotaUsage(1500, 1000, 2000)
+	r.Equal(2500.0, usage.Used, "Unexpected quota usage")
+	r.Equal(500.0, usage.Overage, "Unexpected quota overage")
+	r.Equal(125.0, usage.Percent, "Unexpected quota percentage")
+}
+
+func TestClient_CreateBill(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	payload := librenms.NewBillRequest("Customer C", librenms.BillTypeCDR).
+		SetCDR(50000000).
+		SetDay(1).
+		SetDir95th(librenms.BillDirectionMax).
+		SetPorts(5, 6).
+		SetRef("INV-C")
+
+	resp, err := testAPIClient.CreateBill(payload)
+
+	r.NoError(err, "CreateBill returned an error")
+	r.Equal(3, resp.BillID, "Unexpected bill ID")
+
+	_, err = testAPIClient.CreateBill(librenms.NewBillRequest("Customer D", librenms.BillTypeQuota))
+	r.Error(err, "Expected error for quota bill without a quota")
+
+	_, err = testAPIClient.CreateBill(librenms.NewBillRequest("", librenms.BillTypeCDR).SetCDR(1))
+	r.Error(err, "Expected error for missing name")
+
+	_, err = testAPIClient.CreateBill(librenms.NewBillRequest("Customer E", "monthly"))
+	r.Error(err, "Expected error for invalid type")
+}
+
+func TestClient_UpdateBill(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	payload := &librenms.BillRequest{Notes: "renewed"}
+	resp, err := testAPIClient.UpdateBill(1, payload)
+
+	r.NoError(err, "UpdateBill returned an error")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Nil(payload.ID, "Expected the payload not to be modified")
+
+	_, err = testAPIClient.UpdateBill(1, (&librenms.BillRequest{}).SetDay(32))
+	r.Error(err, "Expected error for invalid day")
+}
+
+func TestClient_DeleteBill(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.DeleteBill(1)
+
+	r.NoError(err, "DeleteBill returned an error")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+}
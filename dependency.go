@@ -0,0 +1,333 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type (
+	// DependencyGraph is an in-memory graph of device dependencies, keyed by device ID.
+	// A child device depends on its parents; LibreNMS suppresses alerts of a child
+	// device while all of its parents are down.
+	//
+	// The zero value is not usable; create one with NewDependencyGraph() or BuildDependencyGraph().
+	DependencyGraph struct {
+		children map[int][]int
+		parents  map[int][]int
+	}
+
+	// deviceParentsRequest is the request payload for setting or removing the parents of a device.
+	deviceParentsRequest struct {
+		ParentIDs string `json:"parent_ids,omitempty"` // comma-separated
+	}
+)
+
+// AddDeviceParents sets the parent devices, by their IDs, of a device by its ID or hostname.
+// The API replaces the existing parents of the device, so include the current parents
+// (see Device.ParentIDs()) to keep them.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#add_parents_to_host
+func (c *Client) AddDeviceParents(identifier string, parentIDs []int) (*BaseResponse, error) {
+	return c.AddDeviceParentsWithContext(context.Background(), identifier, parentIDs)
+}
+
+// AddDeviceParentsWithContext is like AddDeviceParents, but uses the provided context for the request.
+func (c *Client) AddDeviceParentsWithContext(ctx context.Context, identifier string, parentIDs []int) (*BaseResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+	if len(parentIDs) == 0 {
+		return nil, errors.New("at least one parent ID is required")
+	}
+	return c.updateDeviceParents(ctx, http.MethodPost, identifier, parentIDs)
+}
+
+// DeleteDeviceParents removes parent devices, by their IDs, from a device by its ID or hostname.
+// All parents of the device are removed if parentIDs is empty.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#delete_parents_from_host
+func (c *Client) DeleteDeviceParents(identifier string, parentIDs []int) (*BaseResponse, error) {
+	return c.DeleteDeviceParentsWithContext(context.Background(), identifier, parentIDs)
+}
+
+// DeleteDeviceParentsWithContext is like DeleteDeviceParents, but uses the provided context for the request.
+func (c *Client) DeleteDeviceParentsWithContext(ctx context.Context, identifier string, parentIDs []int) (*BaseResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+	return c.updateDeviceParents(ctx, http.MethodDelete, identifier, parentIDs)
+}
+
+// GetDependencyGraph builds a dependency graph of all devices.
+//
+// The dependencies are read from the dependency_parent_id column of the device list, which
+// includes devices without dependencies, so every device is a node of the graph.
+func (c *Client) GetDependencyGraph() (*DependencyGraph, error) {
+	return c.GetDependencyGraphWithContext(context.Background())
+}
+
+// GetDependencyGraphWithContext is like GetDependencyGraph, but uses the provided context for the request.
+func (c *Client) GetDependencyGraphWithContext(ctx context.Context) (*DependencyGraph, error) {
+	graph := NewDependencyGraph()
+	for device, err := range c.Devices(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if err = graph.addDevice(device); err != nil {
+			return nil, err
+		}
+	}
+	return graph, nil
+}
+
+// updateDeviceParents sets or removes the parents of a device, depending on the method.
+func (c *Client) updateDeviceParents(ctx context.Context, method, identifier string, parentIDs []int) (*BaseResponse, error) {
+	ids := make([]string, len(parentIDs))
+	for i, id := range parentIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	payload := &deviceParentsRequest{ParentIDs: strings.Join(ids, ",")}
+
+	req, err := c.newRequest(ctx, method, fmt.Sprintf("%s/%s/parents", deviceEndpoint, identifier), payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
+
+// ParentIDs parses the IDs of the devices this device depends on, from the
+// comma-separated DependencyParentID field. It returns nil if there are none.
+func (d *Device) ParentIDs() ([]int, error) {
+	if d.DependencyParentID == nil || strings.TrimSpace(*d.DependencyParentID) == "" {
+		return nil, nil
+	}
+
+	fields := strings.Split(*d.DependencyParentID, ",")
+	ids := make([]int, 0, len(fields))
+	for _, field := range fields {
+		id, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Errorf("invalid parent ID %q for device %d: %w", field, d.DeviceID, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// BuildDependencyGraph builds a dependency graph from a list of devices, e.g. from GetDevices().
+// Cycles are allowed; use FindCycle() or TopologicalOrder() to detect them.
+func BuildDependencyGraph(devices []Device) (*DependencyGraph, error) {
+	graph := NewDependencyGraph()
+	for _, device := range devices {
+		if err := graph.addDevice(device); err != nil {
+			return nil, err
+		}
+	}
+	return graph, nil
+}
+
+// NewDependencyGraph creates an empty dependency graph.
+func NewDependencyGraph() *DependencyGraph {
+	return &DependencyGraph{
+		children: make(map[int][]int),
+		parents:  make(map[int][]int),
+	}
+}
+
+// AddDevice adds a device to the graph, if it isn't already present.
+func (g *DependencyGraph) AddDevice(deviceID int) {
+	if _, ok := g.parents[deviceID]; !ok {
+		g.parents[deviceID] = nil
+		g.children[deviceID] = nil
+	}
+}
+
+// AddParents adds parent dependencies to a device, adding any missing devices to the graph.
+// A device can't be its own parent, but other cycles are allowed; use CanAddParents()
+// beforehand to prevent them.
+func (g *DependencyGraph) AddParents(deviceID int, parentIDs ...int) error {
+	g.AddDevice(deviceID)
+	for _, parentID := range parentIDs {
+		if parentID == deviceID {
+			return fmt.Errorf("device %d can't depend on itself", deviceID)
+		}
+		g.AddDevice(parentID)
+		if slices.Contains(g.parents[deviceID], parentID) {
+			continue
+		}
+		g.parents[deviceID] = append(g.parents[deviceID], parentID)
+		g.children[parentID] = append(g.children[parentID], deviceID)
+	}
+	return nil
+}
+
+// CanAddParents reports whether adding the parents to a device would keep the graph acyclic.
+// The returned error describes the cycle that would be created, if any.
+func (g *DependencyGraph) CanAddParents(deviceID int, parentIDs ...int) error {
+	for _, parentID := range parentIDs {
+		if parentID == deviceID {
+			return fmt.Errorf("device %d can't depend on itself", deviceID)
+		}
+		// the new edge creates a cycle if the device is already an ancestor of the parent
+		if path := g.path(parentID, deviceID); path != nil {
+			return fmt.Errorf("adding parent %d to device %d creates a dependency cycle: %s",
+				parentID, deviceID, formatCycle(append([]int{deviceID}, path...)))
+		}
+	}
+	return nil
+}
+
+// Children returns the IDs of the devices which directly depend on a device, sorted by ID.
+func (g *DependencyGraph) Children(deviceID int) []int {
+	return sortedCopy(g.children[deviceID])
+}
+
+// Devices returns the IDs of all devices in the graph, sorted by ID.
+func (g *DependencyGraph) Devices() []int {
+	ids := make([]int, 0, len(g.parents))
+	for id := range g.parents {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// FindCycle returns the device IDs of a dependency cycle, starting and ending with the
+// same device (e.g. [1, 2, 1] if devices 1 and 2 depend on each other), or nil if the
+// graph is acyclic.
+func (g *DependencyGraph) FindCycle() []int {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[int]int, len(g.parents))
+	var stack []int
+
+	var visit func(id int) []int
+	visit = func(id int) []int {
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, parentID := range g.Parents(id) {
+			switch state[parentID] {
+			case visiting:
+				start := slices.Index(stack, parentID)
+				return append(slices.Clone(stack[start:]), parentID)
+			case unvisited:
+				if cycle := visit(parentID); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = visited
+		return nil
+	}
+
+	for _, id := range g.Devices() {
+		if state[id] == unvisited {
+			if cycle := visit(id); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// Parents returns the IDs of the devices a device directly depends on, sorted by ID.
+func (g *DependencyGraph) Parents(deviceID int) []int {
+	return sortedCopy(g.parents[deviceID])
+}
+
+// TopologicalOrder returns the IDs of all devices ordered so that every device comes after
+// its parents, e.g. to create or update devices top-down. Devices without a mutual ordering
+// are sorted by ID, so the result is deterministic. An error is returned if the graph
+// contains a cycle.
+func (g *DependencyGraph) TopologicalOrder() ([]int, error) {
+	remaining := make(map[int]int, len(g.parents))
+	var ready []int
+	for id, parentIDs := range g.parents {
+		remaining[id] = len(parentIDs)
+		if len(parentIDs) == 0 {
+			ready = append(ready, id)
+		}
+	}
+	slices.Sort(ready)
+
+	order := make([]int, 0, len(g.parents))
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		order = append(order, id)
+
+		for _, childID := range g.Children(id) {
+			remaining[childID]--
+			if remaining[childID] == 0 {
+				// keep the queue sorted to process devices in ID order
+				i, _ := slices.BinarySearch(ready, childID)
+				ready = slices.Insert(ready, i, childID)
+			}
+		}
+	}
+
+	if len(order) != len(g.parents) {
+		return nil, fmt.Errorf("dependency cycle: %s", formatCycle(g.FindCycle()))
+	}
+	return order, nil
+}
+
+// addDevice adds a device and its parents to the graph.
+func (g *DependencyGraph) addDevice(device Device) error {
+	parentIDs, err := device.ParentIDs()
+	if err != nil {
+		return err
+	}
+	return g.AddParents(device.DeviceID, parentIDs...)
+}
+
+// path returns the device IDs of a path from a device to one of its ancestors,
+// following parent dependencies, or nil if there is none.
+func (g *DependencyGraph) path(from, to int) []int {
+	visited := make(map[int]bool)
+
+	var visit func(id int) []int
+	visit = func(id int) []int {
+		if id == to {
+			return []int{id}
+		}
+		visited[id] = true
+		for _, parentID := range g.Parents(id) {
+			if visited[parentID] {
+				continue
+			}
+			if path := visit(parentID); path != nil {
+				return append([]int{id}, path...)
+			}
+		}
+		return nil
+	}
+	return visit(from)
+}
+
+// formatCycle formats device IDs as a dependency path, e.g. "1 -> 2 -> 1".
+func formatCycle(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, " -> ")
+}
+
+// sortedCopy returns a sorted copy of the IDs.
+func sortedCopy(ids []int) []int {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	return sorted
+}
@@ -0,0 +1,117 @@
+package librenms_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointDeviceParents = "/api/v0/devices/1.1.1.1/parents"
+)
+
+// This init function will register handlers for device dependency API endpoints.
+func init() {
+	// Registering this endpoint outside of handleEndpoint() to verify the payloads.
+	mux.HandleFunc(testEndpointDeviceParents, func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, `{"status": "error", "message": "unexpected payload"}`, http.StatusBadRequest)
+			return
+		}
+
+		var err error
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			if payload["parent_ids"] != "5,2" {
+				http.Error(w, `{"status": "error", "message": "unexpected parent IDs"}`, http.StatusBadRequest)
+				return
+			}
+			_, err = w.Write(loadMockResponse("add_device_parents_200.json"))
+		case http.MethodDelete:
+			if _, ok := payload["parent_ids"]; ok {
+				http.Error(w, `{"status": "error", "message": "unexpected parent IDs"}`, http.StatusBadRequest)
+				return
+			}
+			_, err = w.Write(loadMockResponse("delete_device_parents_200.json"))
+		default:
+			notImplemented(testEndpointDeviceParents, w, r)
+			return
+		}
+		handleWriteErr(err, w)
+	})
+}
+
+func TestClient_DeviceParents(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	// the parents replace the existing ones
+	resp, err := testAPIClient.AddDeviceParents("1.1.1.1", []int{5, 2})
+	r.NoError(err, "AddDeviceParents returned an error")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+
+	_, err = testAPIClient.AddDeviceParents("1.1.1.1", nil)
+	r.Error(err, "Expected error for no parent IDs")
+
+	// no parent IDs removes all parents
+	resp, err = testAPIClient.DeleteDeviceParents("1.1.1.1", nil)
+	r.NoError(err, "DeleteDeviceParents returned an error")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+}
+
+func TestClient_GetDependencyGraph(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	graph, err := testAPIClient.GetDependencyGraph()
+
+	r.NoError(err, "GetDependencyGraph returned an error")
+	r.Equal([]int{1, 2, 5}, graph.Devices(), "Expected all devices in the graph")
+	r.Empty(graph.Parents(1), "Expected no parents")
+}
+
+func TestBuildDependencyGraph(t *testing.T) {
+	r := require.New(t)
+
+	parents := func(ids string) *string { return &ids }
+	devices := []librenms.Device{
+		{DeviceID: 4, DependencyParentID: parents("2, 3")},
+		{DeviceID: 3, DependencyParentID: parents("1")},
+		{DeviceID: 2, DependencyParentID: parents("1")},
+		{DeviceID: 1, DependencyParentID: parents("")},
+		{DeviceID: 5},
+	}
+
+	graph, err := librenms.BuildDependencyGraph(devices)
+	r.NoError(err, "BuildDependencyGraph returned an error")
+	r.Equal([]int{2, 3}, graph.Parents(4), "Unexpected parents")
+	r.Equal([]int{2, 3}, graph.Children(1), "Unexpected children")
+	r.Nil(graph.FindCycle(), "Expected no cycle")
+
+	order, err := graph.TopologicalOrder()
+	r.NoError(err, "TopologicalOrder returned an error")
+	r.Equal([]int{1, 2, 3, 4, 5}, order, "Unexpected topological order")
+
+	r.NoError(graph.CanAddParents(5, 4), "Expected parent to be allowed")
+	r.ErrorContains(graph.CanAddParents(1, 4), "1 -> 4 -> 2 -> 1", "Expected cycle to be detected")
+	r.Error(graph.CanAddParents(1, 1), "Expected self dependency to be rejected")
+
+	r.NoError(graph.AddParents(1, 4), "AddParents returned an error")
+	r.Equal([]int{1, 4, 2, 1}, graph.FindCycle(), "Unexpected cycle")
+
+	_, err = graph.TopologicalOrder()
+	r.ErrorContains(err, "dependency cycle: 1 -> 4 -> 2 -> 1", "Expected cycle error")
+
+	_, err = librenms.BuildDependencyGraph([]librenms.Device{{DeviceID: 1, DependencyParentID: parents("1,x")}})
+	r.Error(err, "Expected error for invalid parent ID")
+
+	_, err = librenms.BuildDependencyGraph([]librenms.Device{{DeviceID: 1, DependencyParentID: parents("1")}})
+	r.Error(err, "Expected error for self dependency")
+}
@@ -1,8 +1,13 @@
 package librenms
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"time"
 )
 
 const (
@@ -10,6 +15,52 @@ const (
 	deviceEndpoint = "devices"
 )
 
+// deviceUpdateFields are the device columns which can be updated with UpdateDevice().
+var deviceUpdateFields = map[string]bool{
+	"agent_uptime":          true,
+	"authalgo":              true,
+	"authlevel":             true,
+	"authname":              true,
+	"authpass":              true,
+	"bgpLocalAs":            true,
+	"community":             true,
+	"cryptoalgo":            true,
+	"cryptopass":            true,
+	"disable_notify":        true,
+	"disabled":              true,
+	"display":               true,
+	"features":              true,
+	"hardware":              true,
+	"hostname":              true,
+	"icon":                  true,
+	"ignore":                true,
+	"ignore_status":         true,
+	"ip":                    true,
+	"location":              true,
+	"location_id":           true,
+	"max_depth":             true,
+	"notes":                 true,
+	"os":                    true,
+	"override_sysLocation":  true,
+	"overwrite_ip":          true,
+	"poller_group":          true,
+	"port":                  true,
+	"port_association_mode": true,
+	"purpose":               true,
+	"retries":               true,
+	"serial":                true,
+	"snmp_disable":          true,
+	"snmpver":               true,
+	"sysContact":            true,
+	"sysDescr":              true,
+	"sysName":               true,
+	"sysObjectID":           true,
+	"timeout":               true,
+	"transport":             true,
+	"type":                  true,
+	"version":               true,
+}
+
 type (
 	// Device represents a device in LibreNMS.
 	//
@@ -27,6 +78,8 @@ type (
 		Community               *string  `json:"community"`
 		CryptoAlgorithm         *string  `json:"cryptoalgo"`
 		CryptoPass              *string  `json:"cryptopass"`
+		DependencyParentHost    *string  `json:"dependency_parent_hostname"` // comma-separated, e.g. "core1,core2"
+		DependencyParentID      *string  `json:"dependency_parent_id"`       // comma-separated, e.g. "1,2"; see ParentIDs()
 		DisableNotify           Bool     `json:"disable_notify"`
 		Disabled                Bool     `json:"disabled"`
 		Display                 *string  `json:"display"`
@@ -76,6 +129,9 @@ type (
 	}
 
 	// DeviceCreateRequest represents the request body for creating a new device in LibreNMS.
+	//
+	// The poller group can be set by ID with PollerGroup, or by name with PollerGroupName,
+	// which CreateDevice resolves to its ID before sending the request.
 	DeviceCreateRequest struct {
 		Hostname            string `json:"hostname"`
 		Display             string `json:"display,omitempty"`
@@ -87,6 +143,7 @@ type (
 		OverrideSysLocation bool   `json:"override_sysLocation,omitempty"`
 		PingFallback        bool   `json:"ping_fallback,omitempty"`
 		PollerGroup         int    `json:"poller_group,omitempty"`
+		PollerGroupName     string `json:"-"`
 		Port                int    `json:"port,omitempty"`
 		PortAssocMode       int    `json:"port_association_mode,omitempty"` // ifIndex(1), ifName(2), ifDescr(3), ifAlias(4)
 		SNMPAuthAlgo        string `json:"authalgo,omitempty"`              // MD5, SHA, SHA-224, SHA-256, SHA384, SHA-512
@@ -106,9 +163,17 @@ type (
 	//
 	// The `Field` slice contains the names of the field(s) to update,
 	// and `Data` contains the corresponding values. Only specify the fields you want to update.
+	//
+	// Prefer building the request with NewDeviceUpdateRequest() and its setters, which
+	// use the correct column names and 0/1 encodings. Call Validate() to also check that
+	// every field is a known device column; UpdateDevice() doesn't, so struct literals with
+	// other columns keep working.
 	DeviceUpdateRequest struct {
 		Field []string `json:"field"`
 		Data  []any    `json:"data"`
+
+		// raw tracks fields added with SetRaw(), which are not validated.
+		raw map[string]bool
 	}
 
 	// DeviceResponse represents a response containing a list of devices from the LibreNMS API.
@@ -132,13 +197,139 @@ type (
 		SysName    string `url:"sysName,omitempty"`
 		Type       string `url:"type,omitempty"`
 	}
+
+	// DeviceAvailability represents the availability of a device over a period of time.
+	DeviceAvailability struct {
+		Duration            int     `json:"duration"` // period in seconds, e.g. 86400 for one day
+		AvailabilityPercent Float64 `json:"availability_perc"`
+	}
+
+	// DeviceAvailabilityResponse represents a response containing the availability of a device.
+	DeviceAvailabilityResponse struct {
+		BaseResponse
+		Availability []DeviceAvailability `json:"availability"`
+	}
+
+	// DeviceDiscoveryResult represents the result of a rediscovery request.
+	DeviceDiscoveryResult struct {
+		Status  int    `json:"status"`
+		Message string `json:"message"`
+	}
+
+	// DeviceDiscoveryResponse represents a response to a rediscovery request.
+	DeviceDiscoveryResponse struct {
+		BaseResponse
+		Result DeviceDiscoveryResult `json:"result"`
+	}
+
+	// DeviceOutage represents a period of time a device was down.
+	//
+	// The timestamps are UNIX epoch seconds. UpAgain is nil if the device is still down.
+	DeviceOutage struct {
+		GoingDown int64  `json:"going_down"`
+		UpAgain   *int64 `json:"up_again"`
+	}
+
+	// DeviceOutagesResponse represents a response containing the outages of a device.
+	DeviceOutagesResponse struct {
+		BaseResponse
+		Outages []DeviceOutage `json:"outages"`
+	}
+
+	// DeviceFDBEntry represents a forwarding database (MAC address table) entry of a device port.
+	DeviceFDBEntry struct {
+		ID         int     `json:"ports_fdb_id"`
+		DeviceID   int     `json:"device_id"`
+		MACAddress string  `json:"mac_address"`
+		PortID     int     `json:"port_id"`
+		VlanID     int     `json:"vlan_id"`
+		CreatedAt  *string `json:"created_at"`
+		UpdatedAt  *string `json:"updated_at"`
+	}
+
+	// DeviceFDBResponse represents a response containing the FDB entries of a device.
+	DeviceFDBResponse struct {
+		BaseResponse
+		Entries []DeviceFDBEntry `json:"ports_fdb"`
+	}
+
+	// PortStackMapping represents a relationship between two ports of a device, as
+	// reported by the IF-MIB ifStackTable (e.g. a LAG and its member ports).
+	PortStackMapping struct {
+		DeviceID      int    `json:"device_id"`
+		HighPortID    int    `json:"port_id_high"` // the higher-layer port, e.g. the LAG
+		LowPortID     int    `json:"port_id_low"`  // the lower-layer port, e.g. a LAG member
+		IfStackStatus string `json:"ifStackStatus"`
+	}
+
+	// PortStackQuery represents the query parameters for GetDevicePortStack().
+	PortStackQuery struct {
+		// ValidMappings filters out mappings where either port ID is 0.
+		ValidMappings bool `url:"valid_mappings,omitempty"`
+	}
+
+	// PortStackResponse represents a response containing the port stack of a device.
+	PortStackResponse struct {
+		BaseResponse
+		Mappings []PortStackMapping `json:"mappings"`
+	}
+
+	// Transceiver represents an optical or copper transceiver installed in a device port.
+	Transceiver struct {
+		ID                  int      `json:"id"`
+		DeviceID            int      `json:"device_id"`
+		PortID              int      `json:"port_id"`
+		Cable               *string  `json:"cable"`
+		Channels            *int     `json:"channels"`
+		Connector           *string  `json:"connector"`
+		CreatedAt           *string  `json:"created_at"`
+		Date                *string  `json:"date"`
+		DDM                 Bool     `json:"ddm"`
+		Distance            *int     `json:"distance"`
+		Encoding            *string  `json:"encoding"`
+		EntityPhysicalIndex *int     `json:"entity_physical_index"`
+		Index               string   `json:"index"`
+		Model               *string  `json:"model"`
+		OUI                 *string  `json:"oui"`
+		Revision            *string  `json:"revision"`
+		Serial              *string  `json:"serial"`
+		Type                *string  `json:"type"`
+		UpdatedAt           *string  `json:"updated_at"`
+		Vendor              *string  `json:"vendor"`
+		Wavelength          *Float64 `json:"wavelength"`
+	}
+
+	// TransceiverResponse represents a response containing the transceivers of a device.
+	TransceiverResponse struct {
+		BaseResponse
+		Transceivers []Transceiver `json:"transceivers"`
+	}
 )
 
 // CreateDevice creates a device by hostname/IP.
 //
 // Documentation: https://docs.librenms.org/API/Devices/#add_device
 func (c *Client) CreateDevice(payload *DeviceCreateRequest) (*DeviceResponse, error) {
-	req, err := c.newRequest(http.MethodPost, fmt.Sprintf("%s/", deviceEndpoint), payload, nil)
+	return c.CreateDeviceWithContext(context.Background(), payload)
+}
+
+// CreateDeviceWithContext is like CreateDevice, but uses the provided context for the request.
+func (c *Client) CreateDeviceWithContext(ctx context.Context, payload *DeviceCreateRequest) (*DeviceResponse, error) {
+	if payload != nil && payload.PollerGroupName != "" {
+		if payload.PollerGroup != 0 {
+			return nil, errors.New("only one of poller group and poller group name can be set")
+		}
+		pollerGroup, err := c.ResolvePollerGroupWithContext(ctx, payload.PollerGroupName)
+		if err != nil {
+			return nil, err
+		}
+		// copy the payload to avoid modifying the caller's request
+		resolved := *payload
+		resolved.PollerGroup = pollerGroup
+		payload = &resolved
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("%s/", deviceEndpoint), payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +341,12 @@ func (c *Client) CreateDevice(payload *DeviceCreateRequest) (*DeviceResponse, er
 //
 // Documentation: https://docs.librenms.org/API/Devices/#del_device
 func (c *Client) DeleteDevice(identifier string) (*DeviceResponse, error) {
-	req, err := c.newRequest(http.MethodDelete, fmt.Sprintf("%s/%s", deviceEndpoint, identifier), nil, nil)
+	return c.DeleteDeviceWithContext(context.Background(), identifier)
+}
+
+// DeleteDeviceWithContext is like DeleteDevice, but uses the provided context for the request.
+func (c *Client) DeleteDeviceWithContext(ctx context.Context, identifier string) (*DeviceResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", deviceEndpoint, identifier), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -158,11 +354,46 @@ func (c *Client) DeleteDevice(identifier string) (*DeviceResponse, error) {
 	return deviceResp, c.do(req, deviceResp)
 }
 
+// DiscoverDevice triggers a rediscovery of a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#discover_device
+func (c *Client) DiscoverDevice(identifier string) (*DeviceDiscoveryResponse, error) {
+	return c.DiscoverDeviceWithContext(context.Background(), identifier)
+}
+
+// DiscoverDeviceWithContext is like DiscoverDevice, but uses the provided context for the request.
+func (c *Client) DiscoverDeviceWithContext(ctx context.Context, identifier string) (*DeviceDiscoveryResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/discover", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(DeviceDiscoveryResponse)
+	return resp, c.do(req, resp)
+}
+
+// Devices returns an iterator over the devices matching the query. Unlike GetDevices, the
+// response is decoded one device at a time, which keeps memory usage low on large inventories.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#list_devices
+func (c *Client) Devices(ctx context.Context, query *DevicesQuery) iter.Seq2[Device, error] {
+	params, err := parseParams(query)
+	if err != nil {
+		return errSeq[Device](err)
+	}
+	return listSeq[Device](ctx, c, deviceEndpoint, params, listConfig{key: "devices"})
+}
+
 // GetDevice retrieves a device by its ID or hostname from the LibreNMS API.
 //
 // Documentation: https://docs.librenms.org/API/Devices/#get_device
 func (c *Client) GetDevice(identifier string) (*DeviceResponse, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf("%s/%s", deviceEndpoint, identifier), nil, nil)
+	return c.GetDeviceWithContext(context.Background(), identifier)
+}
+
+// GetDeviceWithContext is like GetDevice, but uses the provided context for the request.
+func (c *Client) GetDeviceWithContext(ctx context.Context, identifier string) (*DeviceResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", deviceEndpoint, identifier), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -170,16 +401,161 @@ func (c *Client) GetDevice(identifier string) (*DeviceResponse, error) {
 	return deviceResp, c.do(req, deviceResp)
 }
 
+// GetDeviceAvailability retrieves the availability of a device by its ID or hostname,
+// calculated over several periods (e.g. day, week, month and year).
+//
+// Documentation: https://docs.librenms.org/API/Devices/#availability
+func (c *Client) GetDeviceAvailability(identifier string) (*DeviceAvailabilityResponse, error) {
+	return c.GetDeviceAvailabilityWithContext(context.Background(), identifier)
+}
+
+// GetDeviceAvailabilityWithContext is like GetDeviceAvailability, but uses the provided context for the request.
+func (c *Client) GetDeviceAvailabilityWithContext(ctx context.Context, identifier string) (*DeviceAvailabilityResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/availability", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(DeviceAvailabilityResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetDeviceFDB retrieves the forwarding database (MAC address table) entries of a device
+// by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_device_fdb
+func (c *Client) GetDeviceFDB(identifier string) (*DeviceFDBResponse, error) {
+	return c.GetDeviceFDBWithContext(context.Background(), identifier)
+}
+
+// GetDeviceFDBWithContext is like GetDeviceFDB, but uses the provided context for the request.
+func (c *Client) GetDeviceFDBWithContext(ctx context.Context, identifier string) (*DeviceFDBResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/fdb", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(DeviceFDBResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetDeviceMaintenance retrieves whether a device is currently under maintenance, by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#device_under_maintenance
+func (c *Client) GetDeviceMaintenance(identifier string) (*DeviceMaintenanceResponse, error) {
+	return c.GetDeviceMaintenanceWithContext(context.Background(), identifier)
+}
+
+// GetDeviceMaintenanceWithContext is like GetDeviceMaintenance, but uses the provided context for the request.
+func (c *Client) GetDeviceMaintenanceWithContext(ctx context.Context, identifier string) (*DeviceMaintenanceResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/maintenance", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(DeviceMaintenanceResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetDeviceOutages retrieves the outages of a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#outages
+func (c *Client) GetDeviceOutages(identifier string) (*DeviceOutagesResponse, error) {
+	return c.GetDeviceOutagesWithContext(context.Background(), identifier)
+}
+
+// GetDeviceOutagesWithContext is like GetDeviceOutages, but uses the provided context for the request.
+func (c *Client) GetDeviceOutagesWithContext(ctx context.Context, identifier string) (*DeviceOutagesResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/outages", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(DeviceOutagesResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetDevicePorts retrieves the ports of a device by its ID or hostname. Use the query
+// to select the port columns to return.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_port_graphs
+func (c *Client) GetDevicePorts(identifier string, query *PortsQuery) (*PortResponse, error) {
+	return c.GetDevicePortsWithContext(context.Background(), identifier, query)
+}
+
+// GetDevicePortsWithContext is like GetDevicePorts, but uses the provided context for the request.
+func (c *Client) GetDevicePortsWithContext(ctx context.Context, identifier string, query *PortsQuery) (*PortResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/ports", deviceEndpoint, identifier), nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetDevicePortStack retrieves the port stack (ifStackTable) mappings of a device by its ID
+// or hostname, which describe LAG membership and other port layering.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_port_stack
+func (c *Client) GetDevicePortStack(identifier string, query *PortStackQuery) (*PortStackResponse, error) {
+	return c.GetDevicePortStackWithContext(context.Background(), identifier, query)
+}
+
+// GetDevicePortStackWithContext is like GetDevicePortStack, but uses the provided context for the request.
+func (c *Client) GetDevicePortStackWithContext(ctx context.Context, identifier string, query *PortStackQuery) (*PortStackResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/port_stack", deviceEndpoint, identifier), nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortStackResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetDeviceTransceivers retrieves the transceivers of a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_device_transceivers
+func (c *Client) GetDeviceTransceivers(identifier string) (*TransceiverResponse, error) {
+	return c.GetDeviceTransceiversWithContext(context.Background(), identifier)
+}
+
+// GetDeviceTransceiversWithContext is like GetDeviceTransceivers, but uses the provided context for the request.
+func (c *Client) GetDeviceTransceiversWithContext(ctx context.Context, identifier string) (*TransceiverResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/transceivers", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(TransceiverResponse)
+	return resp, c.do(req, resp)
+}
+
 // GetDevices retrieves a list of devices from the LibreNMS API.
 //
 // Documentation: https://docs.librenms.org/API/Devices/#list_devices
 func (c *Client) GetDevices(query *DevicesQuery) (*DeviceResponse, error) {
+	return c.GetDevicesWithContext(context.Background(), query)
+}
+
+// GetDevicesWithContext is like GetDevices, but uses the provided context for the request.
+func (c *Client) GetDevicesWithContext(ctx context.Context, query *DevicesQuery) (*DeviceResponse, error) {
 	params, err := parseParams(query)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := c.newRequest(http.MethodGet, deviceEndpoint, nil, params)
+	req, err := c.newRequest(ctx, http.MethodGet, deviceEndpoint, nil, params)
 	if err != nil {
 		return nil, err
 	}
@@ -188,14 +564,285 @@ func (c *Client) GetDevices(query *DevicesQuery) (*DeviceResponse, error) {
 	return deviceResp, c.do(req, deviceResp)
 }
 
-// UpdateDevice updates a device by its ID or hostname.
+// MaintenanceDevice puts a device into maintenance mode by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#maintenance_device
+func (c *Client) MaintenanceDevice(identifier string, payload *MaintenanceRequest) (*BaseResponse, error) {
+	return c.MaintenanceDeviceWithContext(context.Background(), identifier, payload)
+}
+
+// MaintenanceDeviceWithContext is like MaintenanceDevice, but uses the provided context for the request.
+func (c *Client) MaintenanceDeviceWithContext(ctx context.Context, identifier string, payload *MaintenanceRequest) (*BaseResponse, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("maintenance request is required")
+	}
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%s/maintenance", deviceEndpoint, identifier), payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
+
+// RenameDevice renames a device by its ID or hostname to the new hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#rename_device
+func (c *Client) RenameDevice(identifier, newHostname string) (*BaseResponse, error) {
+	return c.RenameDeviceWithContext(context.Background(), identifier, newHostname)
+}
+
+// RenameDeviceWithContext is like RenameDevice, but uses the provided context for the request.
+func (c *Client) RenameDeviceWithContext(ctx context.Context, identifier, newHostname string) (*BaseResponse, error) {
+	if newHostname == "" {
+		return nil, fmt.Errorf("new hostname is required for renaming a device")
+	}
+
+	uri := fmt.Sprintf("%s/%s/rename/%s", deviceEndpoint, identifier, url.PathEscape(newHostname))
+	req, err := c.newRequest(ctx, http.MethodPatch, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
+
+// UpdateDevice updates a device by its ID or hostname. The payload must contain at least
+// one field, with a value for every field. Unknown columns are sent as-is; call
+// DeviceUpdateRequest.Validate() beforehand to reject them.
 //
 // Documentation: https://docs.librenms.org/API/Devices/#update_device_field
 func (c *Client) UpdateDevice(identifier string, payload *DeviceUpdateRequest) (*BaseResponse, error) {
-	req, err := c.newRequest(http.MethodPatch, fmt.Sprintf("%s/%s", deviceEndpoint, identifier), payload, nil)
+	return c.UpdateDeviceWithContext(context.Background(), identifier, payload)
+}
+
+// UpdateDeviceWithContext is like UpdateDevice, but uses the provided context for the request.
+func (c *Client) UpdateDeviceWithContext(ctx context.Context, identifier string, payload *DeviceUpdateRequest) (*BaseResponse, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("device update request is required")
+	}
+	if err := payload.check(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", deviceEndpoint, identifier), payload, nil)
 	if err != nil {
 		return nil, err
 	}
 	patchResp := new(BaseResponse)
 	return patchResp, c.do(req, patchResp)
 }
+
+// NewDeviceUpdateRequest creates a new, empty DeviceUpdateRequest.
+func NewDeviceUpdateRequest() *DeviceUpdateRequest {
+	return &DeviceUpdateRequest{}
+}
+
+// SetDisabled sets whether the device is disabled (not polled) in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetDisabled(disabled bool) *DeviceUpdateRequest {
+	return r.set("disabled", boolToInt(disabled))
+}
+
+// SetDisableNotify sets whether alert notifications are disabled for the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetDisableNotify(disableNotify bool) *DeviceUpdateRequest {
+	return r.set("disable_notify", boolToInt(disableNotify))
+}
+
+// SetDisplay sets the display name of the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetDisplay(display string) *DeviceUpdateRequest {
+	return r.set("display", display)
+}
+
+// SetHardware sets the hardware of the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetHardware(hardware string) *DeviceUpdateRequest {
+	return r.set("hardware", hardware)
+}
+
+// SetIgnore sets whether alerts for the device are ignored in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetIgnore(ignore bool) *DeviceUpdateRequest {
+	return r.set("ignore", boolToInt(ignore))
+}
+
+// SetIgnoreStatus sets whether the device status is ignored for alerting in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetIgnoreStatus(ignoreStatus bool) *DeviceUpdateRequest {
+	return r.set("ignore_status", boolToInt(ignoreStatus))
+}
+
+// SetLocationID sets the location ID of the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetLocationID(locationID int) *DeviceUpdateRequest {
+	return r.set("location_id", locationID)
+}
+
+// SetNotes sets the notes of the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetNotes(notes string) *DeviceUpdateRequest {
+	return r.set("notes", notes)
+}
+
+// SetOS sets the OS of the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetOS(os string) *DeviceUpdateRequest {
+	return r.set("os", os)
+}
+
+// SetOverrideSysLocation sets whether the device location overrides the SNMP sysLocation in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetOverrideSysLocation(override bool) *DeviceUpdateRequest {
+	return r.set("override_sysLocation", boolToInt(override))
+}
+
+// SetOverwriteIP sets the IP address used to poll the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetOverwriteIP(ip string) *DeviceUpdateRequest {
+	return r.set("overwrite_ip", ip)
+}
+
+// SetPollerGroup sets the poller group ID of the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetPollerGroup(pollerGroup int) *DeviceUpdateRequest {
+	return r.set("poller_group", pollerGroup)
+}
+
+// SetPort sets the SNMP port of the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetPort(port int) *DeviceUpdateRequest {
+	return r.set("port", port)
+}
+
+// SetPortAssociationMode sets the port association mode of the device in the DeviceUpdateRequest:
+// ifIndex(1), ifName(2), ifDescr(3), ifAlias(4).
+func (r *DeviceUpdateRequest) SetPortAssociationMode(mode int) *DeviceUpdateRequest {
+	return r.set("port_association_mode", mode)
+}
+
+// SetPurpose sets the purpose (description) of the device in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetPurpose(purpose string) *DeviceUpdateRequest {
+	return r.set("purpose", purpose)
+}
+
+// SetSNMPAuthAlgo sets the SNMPv3 authentication algorithm (MD5, SHA, SHA-224, SHA-256, SHA-384, SHA-512)
+// in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPAuthAlgo(algo string) *DeviceUpdateRequest {
+	return r.set("authalgo", algo)
+}
+
+// SetSNMPAuthLevel sets the SNMPv3 security level (noAuthNoPriv, authNoPriv, authPriv) in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPAuthLevel(level string) *DeviceUpdateRequest {
+	return r.set("authlevel", level)
+}
+
+// SetSNMPAuthName sets the SNMPv3 username in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPAuthName(name string) *DeviceUpdateRequest {
+	return r.set("authname", name)
+}
+
+// SetSNMPAuthPass sets the SNMPv3 authentication password in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPAuthPass(pass string) *DeviceUpdateRequest {
+	return r.set("authpass", pass)
+}
+
+// SetSNMPCommunity sets the SNMPv1/v2c community in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPCommunity(community string) *DeviceUpdateRequest {
+	return r.set("community", community)
+}
+
+// SetSNMPCryptoAlgo sets the SNMPv3 privacy algorithm (DES, AES, AES-192, AES-256, AES-256-C)
+// in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPCryptoAlgo(algo string) *DeviceUpdateRequest {
+	return r.set("cryptoalgo", algo)
+}
+
+// SetSNMPCryptoPass sets the SNMPv3 privacy password in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPCryptoPass(pass string) *DeviceUpdateRequest {
+	return r.set("cryptopass", pass)
+}
+
+// SetSNMPDisable sets whether SNMP is disabled for the device (ping only) in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPDisable(disable bool) *DeviceUpdateRequest {
+	return r.set("snmp_disable", boolToInt(disable))
+}
+
+// SetSNMPVersion sets the SNMP version (v1, v2c, v3) in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetSNMPVersion(version string) *DeviceUpdateRequest {
+	return r.set("snmpver", version)
+}
+
+// SetTransport sets the SNMP transport (udp, tcp, udp6, tcp6) in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetTransport(transport string) *DeviceUpdateRequest {
+	return r.set("transport", transport)
+}
+
+// SetType sets the device type (e.g. server, network, firewall) in the DeviceUpdateRequest.
+func (r *DeviceUpdateRequest) SetType(deviceType string) *DeviceUpdateRequest {
+	return r.set("type", deviceType)
+}
+
+// SetRaw sets an arbitrary field in the DeviceUpdateRequest. The field name and value are
+// sent as-is and are not validated, so this can be used for columns without a dedicated setter.
+func (r *DeviceUpdateRequest) SetRaw(field string, value any) *DeviceUpdateRequest {
+	if r.raw == nil {
+		r.raw = make(map[string]bool)
+	}
+	r.raw[field] = true
+	return r.set(field, value)
+}
+
+// Validate checks that the request contains at least one field, that every field has a
+// value, and that every field is a known device column (or was added with SetRaw()).
+func (r *DeviceUpdateRequest) Validate() error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	for _, field := range r.Field {
+		if !deviceUpdateFields[field] && !r.raw[field] {
+			return fmt.Errorf("unknown device field %q, use SetRaw() to update it anyway", field)
+		}
+	}
+	return nil
+}
+
+// check checks that the request contains at least one field, and that every field has a value.
+func (r *DeviceUpdateRequest) check() error {
+	if len(r.Field) == 0 {
+		return fmt.Errorf("device update request contains no fields")
+	}
+	if len(r.Field) != len(r.Data) {
+		return fmt.Errorf("device update request has %d fields but %d values", len(r.Field), len(r.Data))
+	}
+	return nil
+}
+
+// set adds the field and value to the request, replacing the value if the field is already set.
+func (r *DeviceUpdateRequest) set(field string, value any) *DeviceUpdateRequest {
+	for i, f := range r.Field {
+		if f == field && i < len(r.Data) {
+			r.Data[i] = value
+			return r
+		}
+	}
+	r.Field = append(r.Field, field)
+	r.Data = append(r.Data, value)
+	return r
+}
+
+// Start returns the time the device went down.
+func (o DeviceOutage) Start() time.Time {
+	return time.Unix(o.GoingDown, 0)
+}
+
+// End returns the time the device came back up, and false if the device is still down.
+func (o DeviceOutage) End() (time.Time, bool) {
+	if o.UpAgain == nil {
+		return time.Time{}, false
+	}
+	return time.Unix(*o.UpAgain, 0), true
+}
+
+// Duration returns the duration of the outage. If the device is still down,
+// the duration until now is returned.
+func (o DeviceOutage) Duration() time.Duration {
+	end, ok := o.End()
+	if !ok {
+		end = time.Now()
+	}
+	return end.Sub(o.Start())
+}
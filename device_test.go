@@ -3,6 +3,7 @@ package librenms_test
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/jokelyo/go-librenms"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,14 @@ const (
 	testEndpointDevices      = "/api/v0/devices"
 	testEndpointDevicesSlash = "/api/v0/devices/"
 	testEndpointDevice       = "/api/v0/devices/1.1.1.1"
+
+	testEndpointDeviceAvailability = "/api/v0/devices/1.1.1.1/availability"
+	testEndpointDeviceDiscover     = "/api/v0/devices/1.1.1.1/discover"
+	testEndpointDeviceFDB          = "/api/v0/devices/1.1.1.1/fdb"
+	testEndpointDeviceOutages      = "/api/v0/devices/1.1.1.1/outages"
+	testEndpointDeviceRename       = "/api/v0/devices/1.1.1.1/rename/router1.example.com"
+	testEndpointDevicePortStack    = "/api/v0/devices/1.1.1.1/port_stack"
+	testEndpointDeviceTransceivers = "/api/v0/devices/1.1.1.1/transceivers"
 )
 
 // This init function will register handlers for device-related API endpoints.
@@ -29,6 +38,34 @@ func init() {
 	handleEndpoint(testEndpointDevices, mockResponses{
 		http.MethodGet: loadMockResponse("get_devices_200.json"),
 	})
+
+	handleEndpoint(testEndpointDeviceAvailability, mockResponses{
+		http.MethodGet: loadMockResponse("get_device_availability_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceDiscover, mockResponses{
+		http.MethodGet: loadMockResponse("discover_device_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceOutages, mockResponses{
+		http.MethodGet: loadMockResponse("get_device_outages_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceRename, mockResponses{
+		http.MethodPatch: loadMockResponse("rename_device_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceFDB, mockResponses{
+		http.MethodGet: loadMockResponse("get_device_fdb_200.json"),
+	})
+
+	handleEndpoint(testEndpointDevicePortStack, mockResponses{
+		http.MethodGet: loadMockResponse("get_device_port_stack_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceTransceivers, mockResponses{
+		http.MethodGet: loadMockResponse("get_device_transceivers_200.json"),
+	})
 }
 
 func TestClient_GetDevice(t *testing.T) {
@@ -149,3 +186,164 @@ func TestClient_UpdateDevice(t *testing.T) {
 	r.Equal("ok", deviceResp.Status, "Expected status 'ok'")
 	r.Equal("Device fields have been updated", deviceResp.Message, "Update message mismatch")
 }
+
+func TestClient_GetDeviceFDB(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceFDB("1.1.1.1")
+
+	r.NoError(err, "GetDeviceFDB returned an error")
+	r.NotNil(resp, "GetDeviceFDB response is nil")
+
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Len(resp.Entries, 1, "Expected 1 FDB entry")
+	r.Equal("525400abcdef", resp.Entries[0].MACAddress, "Unexpected MAC address")
+	r.Equal(2, resp.Entries[0].PortID, "Expected PortID 2")
+}
+
+func TestClient_GetDevicePortStack(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDevicePortStack("1.1.1.1", &librenms.PortStackQuery{ValidMappings: true})
+
+	r.NoError(err, "GetDevicePortStack returned an error")
+	r.NotNil(resp, "GetDevicePortStack response is nil")
+
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Len(resp.Mappings, 2, "Expected 2 mappings")
+	r.Equal(10, resp.Mappings[0].HighPortID, "Expected high port ID 10")
+	r.Equal(2, resp.Mappings[0].LowPortID, "Expected low port ID 2")
+}
+
+func TestClient_GetDeviceTransceivers(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceTransceivers("1.1.1.1")
+
+	r.NoError(err, "GetDeviceTransceivers returned an error")
+	r.NotNil(resp, "GetDeviceTransceivers response is nil")
+
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Len(resp.Transceivers, 1, "Expected 1 transceiver")
+
+	transceiver := resp.Transceivers[0]
+	r.Equal("SFP+", *transceiver.Type, "Expected type 'SFP+'")
+	r.Equal(librenms.Bool(true), transceiver.DDM, "Expected DDM true (1)")
+	r.Equal(librenms.Float64(850), *transceiver.Wavelength, "Expected wavelength 850")
+}
+
+func TestClient_DiscoverDevice(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.DiscoverDevice("1.1.1.1")
+
+	r.NoError(err, "DiscoverDevice returned an error")
+	r.NotNil(resp, "DiscoverDevice response is nil")
+
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Equal(0, resp.Result.Status, "Expected result status 0")
+	r.Equal("Device will be rediscovered", resp.Result.Message, "Unexpected result message")
+}
+
+func TestClient_RenameDevice(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.RenameDevice("1.1.1.1", "router1.example.com")
+
+	r.NoError(err, "RenameDevice returned an error")
+	r.NotNil(resp, "RenameDevice response is nil")
+	r.Equal("Device has been renamed", resp.Message, "Unexpected message")
+
+	_, err = testAPIClient.RenameDevice("1.1.1.1", "")
+	r.Error(err, "Expected error for empty hostname")
+}
+
+func TestClient_GetDeviceAvailability(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceAvailability("1.1.1.1")
+
+	r.NoError(err, "GetDeviceAvailability returned an error")
+	r.NotNil(resp, "GetDeviceAvailability response is nil")
+
+	r.Len(resp.Availability, 4, "Expected 4 availability periods")
+	r.Equal(86400, resp.Availability[0].Duration, "Expected duration 86400")
+	r.Equal(librenms.Float64(99.871), resp.Availability[1].AvailabilityPercent, "Unexpected availability")
+	r.Equal(librenms.Float64(99.95), resp.Availability[2].AvailabilityPercent, "Unexpected availability")
+}
+
+func TestClient_GetDeviceOutages(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceOutages("1.1.1.1")
+
+	r.NoError(err, "GetDeviceOutages returned an error")
+	r.NotNil(resp, "GetDeviceOutages response is nil")
+	r.Len(resp.Outages, 2, "Expected 2 outages")
+
+	outage := resp.Outages[0]
+	r.Equal(6*time.Minute, outage.Duration(), "Expected 6 minute outage")
+	end, ok := outage.End()
+	r.True(ok, "Expected outage to have ended")
+	r.Equal(int64(1748800360), end.Unix(), "Unexpected outage end")
+
+	_, ok = resp.Outages[1].End()
+	r.False(ok, "Expected outage to be ongoing")
+}
+
+func TestClient_UpdateDevice_Builder(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	payload := librenms.NewDeviceUpdateRequest().
+		SetDisplay("core-router").
+		SetIgnore(true).
+		SetDisabled(false).
+		SetLocationID(3).
+		SetDisplay("core-router-1")
+
+	r.Equal([]string{"display", "ignore", "disabled", "location_id"}, payload.Field, "Unexpected fields")
+	r.Equal([]any{"core-router-1", 1, 0, 3}, payload.Data, "Unexpected data")
+
+	deviceResp, err := testAPIClient.UpdateDevice("1.1.1.1", payload)
+
+	r.NoError(err, "UpdateDevice returned an error")
+	r.Equal("ok", deviceResp.Status, "Expected status 'ok'")
+}
+
+func TestDeviceUpdateRequest_Validate(t *testing.T) {
+	r := require.New(t)
+
+	r.Error(librenms.NewDeviceUpdateRequest().Validate(), "Expected error for empty request")
+
+	mismatched := &librenms.DeviceUpdateRequest{Field: []string{"display", "notes"}, Data: []any{"x"}}
+	r.ErrorContains(mismatched.Validate(), "2 fields but 1 values", "Expected length mismatch error")
+
+	unknown := &librenms.DeviceUpdateRequest{Field: []string{"dispaly"}, Data: []any{"x"}}
+	r.ErrorContains(unknown.Validate(), "unknown device field", "Expected unknown field error")
+
+	// UpdateDevice only checks the request is well-formed, so other columns still work
+	_, err := testAPIClient.UpdateDevice("1.1.1.1", unknown)
+	r.NoError(err, "Expected UpdateDevice to send unknown fields")
+
+	_, err = testAPIClient.UpdateDevice("1.1.1.1", mismatched)
+	r.Error(err, "Expected UpdateDevice to reject mismatched fields")
+
+	raw := librenms.NewDeviceUpdateRequest().SetRaw("custom_column", "x")
+	r.NoError(raw.Validate(), "Expected raw fields to be permitted")
+}
@@ -1,6 +1,7 @@
 package librenms
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,7 +30,7 @@ type (
 		Condition string            `json:"condition"`
 		Joins     [][]string        `json:"joins"`
 		Rules     []DeviceGroupRule `json:"rules"`
-		Valid     bool              `json:"valid""`
+		Valid     bool              `json:"valid"`
 	}
 
 	// DeviceGroupRule represents a rule within a device group. This is a recursive structure.
@@ -102,7 +103,12 @@ type (
 //
 // Documentation: https://docs.librenms.org/API/DeviceGroups/#add_devicegroup
 func (c *Client) CreateDeviceGroup(group *DeviceGroupCreateRequest) (*DeviceGroupCreateResponse, error) {
-	req, err := c.newRequest(http.MethodPost, deviceGroupEndpoint, group, nil)
+	return c.CreateDeviceGroupWithContext(context.Background(), group)
+}
+
+// CreateDeviceGroupWithContext is like CreateDeviceGroup, but uses the provided context for the request.
+func (c *Client) CreateDeviceGroupWithContext(ctx context.Context, group *DeviceGroupCreateRequest) (*DeviceGroupCreateResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodPost, deviceGroupEndpoint, group, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -115,12 +121,17 @@ func (c *Client) CreateDeviceGroup(group *DeviceGroupCreateRequest) (*DeviceGrou
 //
 // Documentation: https://docs.librenms.org/API/DeviceGroups/#delete_devicegroup
 func (c *Client) DeleteDeviceGroup(identifier string) (*BaseResponse, error) {
+	return c.DeleteDeviceGroupWithContext(context.Background(), identifier)
+}
+
+// DeleteDeviceGroupWithContext is like DeleteDeviceGroup, but uses the provided context for the request.
+func (c *Client) DeleteDeviceGroupWithContext(ctx context.Context, identifier string) (*BaseResponse, error) {
 	uri, err := url.Parse(fmt.Sprintf("%s/%s", deviceGroupEndpoint, identifier))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse URI: %w", err)
 	}
 
-	req, err := c.newRequest(http.MethodDelete, uri.String(), nil, nil)
+	req, err := c.newRequest(ctx, http.MethodDelete, uri.String(), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +143,12 @@ func (c *Client) DeleteDeviceGroup(identifier string) (*BaseResponse, error) {
 // modified payload with the single host (if a match is found).
 // This is primarily a convenience function for the Terraform provider.
 func (c *Client) GetDeviceGroup(identifier string) (*DeviceGroupResponse, error) {
-	req, err := c.newRequest(http.MethodGet, deviceGroupEndpoint, nil, nil)
+	return c.GetDeviceGroupWithContext(context.Background(), identifier)
+}
+
+// GetDeviceGroupWithContext is like GetDeviceGroup, but uses the provided context for the request.
+func (c *Client) GetDeviceGroupWithContext(ctx context.Context, identifier string) (*DeviceGroupResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, deviceGroupEndpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +183,12 @@ func (c *Client) GetDeviceGroup(identifier string) (*DeviceGroupResponse, error)
 //
 // Documentation: https://docs.librenms.org/API/DeviceGroups/#get_devicegroups
 func (c *Client) GetDeviceGroups() (*DeviceGroupResponse, error) {
-	req, err := c.newRequest(http.MethodGet, deviceGroupEndpoint, nil, nil)
+	return c.GetDeviceGroupsWithContext(context.Background())
+}
+
+// GetDeviceGroupsWithContext is like GetDeviceGroups, but uses the provided context for the request.
+func (c *Client) GetDeviceGroupsWithContext(ctx context.Context) (*DeviceGroupResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, deviceGroupEndpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +202,12 @@ func (c *Client) GetDeviceGroups() (*DeviceGroupResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/DeviceGroups/#get_devices_by_group
 func (c *Client) GetDeviceGroupMembers(identifier string) (*DeviceGroupMembersResponse, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf("%s/%s", deviceGroupEndpoint, identifier), nil, nil)
+	return c.GetDeviceGroupMembersWithContext(context.Background(), identifier)
+}
+
+// GetDeviceGroupMembersWithContext is like GetDeviceGroupMembers, but uses the provided context for the request.
+func (c *Client) GetDeviceGroupMembersWithContext(ctx context.Context, identifier string) (*DeviceGroupMembersResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", deviceGroupEndpoint, identifier), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -190,17 +216,48 @@ func (c *Client) GetDeviceGroupMembers(identifier string) (*DeviceGroupMembersRe
 	return resp, c.do(req, resp)
 }
 
+// MaintenanceDeviceGroup puts all devices of a device group into maintenance mode.
+// The identifier can be either the group ID or the group name.
+//
+// Documentation: https://docs.librenms.org/API/DeviceGroups/#maintenance_devicegroup
+func (c *Client) MaintenanceDeviceGroup(identifier string, payload *MaintenanceRequest) (*BaseResponse, error) {
+	return c.MaintenanceDeviceGroupWithContext(context.Background(), identifier, payload)
+}
+
+// MaintenanceDeviceGroupWithContext is like MaintenanceDeviceGroup, but uses the provided context for the request.
+func (c *Client) MaintenanceDeviceGroupWithContext(ctx context.Context, identifier string, payload *MaintenanceRequest) (*BaseResponse, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("maintenance request is required")
+	}
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%s/maintenance", deviceGroupEndpoint, identifier), payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
+
 // UpdateDeviceGroup updates an existing device group in the LibreNMS API.
 //
 // The documentation states it uses name rather than ID to reference the group, but both seem to work (as of v25.5).
 // Documentation: https://docs.librenms.org/API/DeviceGroups/#update_devicegroup
 func (c *Client) UpdateDeviceGroup(identifier string, payload *DeviceGroupUpdateRequest) (*BaseResponse, error) {
+	return c.UpdateDeviceGroupWithContext(context.Background(), identifier, payload)
+}
+
+// UpdateDeviceGroupWithContext is like UpdateDeviceGroup, but uses the provided context for the request.
+func (c *Client) UpdateDeviceGroupWithContext(ctx context.Context, identifier string, payload *DeviceGroupUpdateRequest) (*BaseResponse, error) {
 	uri, err := url.Parse(fmt.Sprintf("%s/%s", deviceGroupEndpoint, identifier))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse URI: %w", err)
 	}
 
-	req, err := c.newRequest(http.MethodPatch, uri.String(), payload, nil)
+	req, err := c.newRequest(ctx, http.MethodPatch, uri.String(), payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,24 +1,213 @@
 package librenms
 
 import (
-	"fmt"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
+var (
+	// ErrNotFound indicates the requested resource does not exist.
+	ErrNotFound = errors.New("librenms: resource not found")
+	// ErrUnauthorized indicates the API token is missing, invalid or lacks the required permissions.
+	ErrUnauthorized = errors.New("librenms: unauthorized")
+	// ErrConflict indicates the resource conflicts with an existing one, e.g. it already exists.
+	ErrConflict = errors.New("librenms: resource conflict")
+	// ErrValidation indicates the request was rejected due to invalid or missing input.
+	ErrValidation = errors.New("librenms: validation failed")
+	// ErrServer indicates the LibreNMS server failed to process the request.
+	ErrServer = errors.New("librenms: server error")
+)
+
+// errorPatterns maps known LibreNMS error message fragments to sentinel errors.
+//
+// The API is not consistent with its status codes; e.g. some lookups return a 400 or even
+// a 200 with an error status when a resource does not exist, so the message is used to
+// refine these ambiguous responses. Fragments only match whole words.
+var errorPatterns = []struct {
+	fragment *regexp.Regexp
+	err      error
+}{
+	{wordPattern("does not exist"), ErrNotFound},
+	{wordPattern("not found"), ErrNotFound},
+	{wordPattern("no such"), ErrNotFound},
+	{wordPattern("already exists"), ErrConflict},
+	{wordPattern("already have"), ErrConflict},
+	{wordPattern("duplicate"), ErrConflict},
+	{wordPattern("missing"), ErrValidation},
+	{wordPattern("invalid"), ErrValidation},
+	{wordPattern("is required"), ErrValidation},
+}
+
+// maxErrorBodyLength is the maximum number of bytes of the raw response body kept in an ErrorResponse.
+const maxErrorBodyLength = 1024
+
 type (
 	// ErrorResponse represents an error response from the LibreNMS API.
+	//
+	// The request and response metadata is captured when the error is created, so the
+	// error is safe to log, compare and serialize even after the response has been closed.
+	// The auth token is sent as a header and is never included in the captured URL.
+	//
+	// It supports errors.Is() with the sentinel errors defined in this package
+	// (ErrNotFound, ErrUnauthorized, ErrConflict, ErrValidation, ErrServer).
 	ErrorResponse struct {
+		// Response is the raw HTTP response, if any. Its body has already been consumed.
+		// Prefer the captured fields below, as this may be nil.
 		Response *http.Response `json:"-"`
-		Message  string         `json:"message"`
-		Status   string         `json:"status"`
+
+		Method     string `json:"method,omitempty"`
+		URL        string `json:"url,omitempty"`
+		StatusCode int    `json:"status_code,omitempty"`
+		HTTPStatus string `json:"http_status,omitempty"` // e.g. "404 Not Found"
+		Status     string `json:"status,omitempty"`      // LibreNMS status, e.g. "error"
+		Message    string `json:"message,omitempty"`
+		Body       string `json:"body,omitempty"` // raw response body, truncated to maxErrorBodyLength
 	}
 )
 
+// newErrorResponse creates an ErrorResponse from the given HTTP response and its body.
+func newErrorResponse(resp *http.Response, body []byte) *ErrorResponse {
+	e := &ErrorResponse{
+		Response: resp,
+		Body:     truncate(string(body), maxErrorBodyLength),
+	}
+	if resp == nil {
+		return e
+	}
+
+	e.StatusCode = resp.StatusCode
+	e.HTTPStatus = resp.Status
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			e.URL = redactURL(resp.Request.URL)
+		}
+	}
+
+	if len(body) > 0 {
+		apiErr := new(BaseResponse)
+		if err := json.Unmarshal(body, apiErr); err != nil {
+			e.Message = e.Body
+		} else {
+			e.Status = apiErr.Status
+			e.Message = apiErr.Message
+		}
+	}
+	return e
+}
+
 // Error implements the error interface for ErrorResponse.
 func (e *ErrorResponse) Error() string {
-	errMsg := fmt.Sprintf("%s %s", e.Response.Status, e.Response.Request.URL.String())
+	parts := make([]string, 0, 3)
+	if e.Method != "" || e.URL != "" {
+		parts = append(parts, strings.TrimSpace(e.Method+" "+e.URL))
+	}
+	switch {
+	case e.HTTPStatus != "":
+		parts = append(parts, e.HTTPStatus)
+	case e.StatusCode != 0:
+		parts = append(parts, strconv.Itoa(e.StatusCode))
+	}
 	if e.Message != "" {
-		errMsg += fmt.Sprintf(": %s", e.Message)
+		parts = append(parts, e.Message)
+	}
+	if len(parts) == 0 {
+		return "librenms: unknown API error"
+	}
+	return strings.Join(parts, ": ")
+}
+
+// Is reports whether the error matches the given sentinel error, which allows
+// using errors.Is(err, librenms.ErrNotFound) on errors returned by the client.
+func (e *ErrorResponse) Is(target error) bool {
+	kind := e.kind()
+	return kind != nil && kind == target
+}
+
+// kind classifies the error into one of the sentinel errors. Unambiguous HTTP status
+// codes take precedence; otherwise known message patterns are checked before falling
+// back to the status code. It returns nil if the error cannot be classified.
+func (e *ErrorResponse) kind() error {
+	statusCode := e.StatusCode
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode >= http.StatusInternalServerError:
+		return ErrServer
+	}
+
+	for _, p := range errorPatterns {
+		if p.fragment.MatchString(e.Message) {
+			return p.err
+		}
+	}
+
+	if statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity {
+		return ErrValidation
+	}
+	return nil
+}
+
+// wordPattern compiles a case-insensitive pattern matching the fragment as whole words.
+func wordPattern(fragment string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(fragment) + `\b`)
+}
+
+// IsNotFound reports whether the error indicates that a resource does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether the error indicates an authentication or authorization failure.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsConflict reports whether the error indicates a conflict, such as a resource that already exists.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsValidation reports whether the error indicates the request failed validation.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsServerError reports whether the error indicates a server-side failure.
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServer)
+}
+
+// redactURL returns the URL as a string with any user info and token query parameters removed.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+
+	if redacted.RawQuery != "" {
+		q := redacted.Query()
+		for key := range q {
+			if strings.Contains(strings.ToLower(key), "token") {
+				q.Del(key)
+			}
+		}
+		redacted.RawQuery = q.Encode()
+	}
+	return redacted.String()
+}
+
+// truncate shortens the string to at most n bytes.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
 	}
-	return errMsg
+	return s[:n] + "...(truncated)"
 }
@@ -0,0 +1,124 @@
+package librenms_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointDeviceMissing = "/api/v0/devices/missing.example.com"
+	testEndpointDeviceError   = "/api/v0/devices/error.example.com"
+)
+
+// This init function will register handlers for error-related API endpoints.
+func init() {
+	mux.HandleFunc(testEndpointDeviceMissing, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write(loadMockResponse("get_device_404.json"))
+		handleWriteErr(err, w)
+	})
+
+	mux.HandleFunc(testEndpointDeviceError, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, err := w.Write(loadMockResponse("create_device_500.json"))
+		handleWriteErr(err, w)
+	})
+}
+
+func TestClient_ErrorNotFound(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	_, err := testAPIClient.GetDevice("missing.example.com")
+	r.Error(err, "Expected error for missing device")
+	r.True(librenms.IsNotFound(err), "Expected IsNotFound to be true")
+	r.ErrorIs(err, librenms.ErrNotFound, "Expected ErrNotFound")
+	r.False(librenms.IsServerError(err), "Expected IsServerError to be false")
+
+	var errResp *librenms.ErrorResponse
+	r.True(errors.As(err, &errResp), "Expected an ErrorResponse")
+	r.Equal("Device missing.example.com does not exist", errResp.Message, "Unexpected error message")
+}
+
+func TestClient_ErrorServer(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	_, err := testAPIClient.GetDevice("error.example.com")
+	r.Error(err, "Expected error for server failure")
+	r.True(librenms.IsServerError(err), "Expected IsServerError to be true")
+	r.False(librenms.IsNotFound(err), "Expected IsNotFound to be false")
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	r := require.New(t)
+
+	tests := []struct {
+		name     string
+		err      *librenms.ErrorResponse
+		expected error
+	}{
+		{"unauthorized", &librenms.ErrorResponse{StatusCode: http.StatusUnauthorized}, librenms.ErrUnauthorized},
+		{"forbidden", &librenms.ErrorResponse{StatusCode: http.StatusForbidden}, librenms.ErrUnauthorized},
+		{"conflict status", &librenms.ErrorResponse{StatusCode: http.StatusConflict}, librenms.ErrConflict},
+		{"conflict message", &librenms.ErrorResponse{StatusCode: http.StatusBadRequest, Message: "Device already exists"}, librenms.ErrConflict},
+		{"not found message", &librenms.ErrorResponse{StatusCode: http.StatusUnprocessableEntity, Message: "Device foo does not exist"}, librenms.ErrNotFound},
+		{"validation", &librenms.ErrorResponse{StatusCode: http.StatusBadRequest}, librenms.ErrValidation},
+		{"validation message", &librenms.ErrorResponse{StatusCode: http.StatusOK, Message: "Missing the device hostname"}, librenms.ErrValidation},
+		{"no response", &librenms.ErrorResponse{Message: "No such device"}, librenms.ErrNotFound},
+		// unambiguous status codes take precedence over the message
+		{"unauthorized message", &librenms.ErrorResponse{StatusCode: http.StatusUnauthorized, Message: "Invalid token"}, librenms.ErrUnauthorized},
+		{"forbidden message", &librenms.ErrorResponse{StatusCode: http.StatusForbidden, Message: "Missing permission"}, librenms.ErrUnauthorized},
+		{"server message", &librenms.ErrorResponse{StatusCode: http.StatusInternalServerError, Message: "Template not found"}, librenms.ErrServer},
+		{"not found status", &librenms.ErrorResponse{StatusCode: http.StatusNotFound, Message: "Invalid route"}, librenms.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		r.ErrorIs(tt.err, tt.expected, "Unexpected classification for %s", tt.name)
+	}
+
+	// fragments only match whole words, e.g. not within hostnames
+	partial := &librenms.ErrorResponse{StatusCode: http.StatusOK, Message: "Failed to poll invalidhost.example.com"}
+	r.False(librenms.IsValidation(partial), "Expected no classification for a partial word match")
+}
+
+func TestErrorResponse_Metadata(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	_, err := testAPIClient.GetDevice("missing.example.com")
+	r.Error(err, "Expected error for missing device")
+
+	var errResp *librenms.ErrorResponse
+	r.True(errors.As(err, &errResp), "Expected an ErrorResponse")
+	r.Equal(http.MethodGet, errResp.Method, "Unexpected method")
+	r.Equal(testServer.URL+testEndpointDeviceMissing, errResp.URL, "Unexpected URL")
+	r.Equal(http.StatusNotFound, errResp.StatusCode, "Unexpected status code")
+	r.Equal("error", errResp.Status, "Unexpected LibreNMS status")
+	r.Contains(errResp.Body, "does not exist", "Expected raw body to be captured")
+
+	data, err := json.Marshal(errResp)
+	r.NoError(err, "Failed to marshal ErrorResponse")
+	r.NotContains(string(data), "test-token-global", "Auth token must not be serialized")
+	r.Contains(string(data), `"status_code":404`, "Expected status code in JSON")
+}
+
+func TestErrorResponse_ErrorWithoutResponse(t *testing.T) {
+	r := require.New(t)
+
+	errResp := &librenms.ErrorResponse{}
+	r.NotPanics(func() { _ = errResp.Error() }, "Error() should not panic without a response")
+
+	errResp = &librenms.ErrorResponse{Message: "something went wrong"}
+	r.Equal("something went wrong", errResp.Error(), "Unexpected error string")
+}
@@ -0,0 +1,264 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// GraphOutputPNG requests a PNG graph image.
+	GraphOutputPNG = "png"
+	// GraphOutputSVG requests an SVG graph image.
+	GraphOutputSVG = "svg"
+
+	// portGroupGraphEndpoint is the API endpoint for port group graphs, which differs from
+	// the port group management endpoint.
+	portGroupGraphEndpoint = "portgroups"
+
+	// graphErrorBodyLimit is the maximum number of bytes of an unexpected graph response
+	// included in the returned error.
+	graphErrorBodyLimit = 256
+)
+
+type (
+	// GraphOptions contains the optional parameters for graph requests.
+	//
+	// From and To default to the last day, and the image size defaults to the
+	// LibreNMS server's configuration.
+	GraphOptions struct {
+		From    *time.Time
+		To      *time.Time
+		Width   *int
+		Height  *int
+		Output  *string
+		IfDescr *bool
+	}
+
+	// GraphImage describes a graph image written by one of the graph methods.
+	GraphImage struct {
+		ContentType string
+		Size        int64
+	}
+)
+
+// GetBillGraph writes a graph image of a bill to w. The graph type is e.g. "bits".
+//
+// Documentation: https://docs.librenms.org/API/Bills/#get_bill_graph
+func (c *Client) GetBillGraph(billID int, graphType string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	return c.GetBillGraphWithContext(context.Background(), billID, graphType, opts, w)
+}
+
+// GetBillGraphWithContext is like GetBillGraph, but uses the provided context for the request.
+func (c *Client) GetBillGraphWithContext(ctx context.Context, billID int, graphType string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	if graphType == "" {
+		return nil, errors.New("graph type is required")
+	}
+	uri := fmt.Sprintf("%s/%d/graphs/%s", billEndpoint, billID, url.PathEscape(graphType))
+	return c.getGraph(ctx, uri, opts, w)
+}
+
+// GetDeviceGraph writes a graph image of a device, identified by its ID or hostname, to w.
+// The graph type is e.g. "device_processor" or "device_bits".
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_graph_generic_by_hostname
+func (c *Client) GetDeviceGraph(identifier, graphType string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	return c.GetDeviceGraphWithContext(context.Background(), identifier, graphType, opts, w)
+}
+
+// GetDeviceGraphWithContext is like GetDeviceGraph, but uses the provided context for the request.
+func (c *Client) GetDeviceGraphWithContext(ctx context.Context, identifier, graphType string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	if identifier == "" || graphType == "" {
+		return nil, errors.New("device identifier and graph type are required")
+	}
+	uri := fmt.Sprintf("%s/%s/%s", deviceEndpoint, identifier, url.PathEscape(graphType))
+	return c.getGraph(ctx, uri, opts, w)
+}
+
+// GetDevicePortGraph writes a graph image of a device port, identified by its ifName, to w.
+// Use GraphOptions.SetIfDescr() to identify the port by its ifDescr instead.
+// The graph type is e.g. "port_bits" or "port_upkts".
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_graph_by_port_hostname
+func (c *Client) GetDevicePortGraph(identifier, ifName, graphType string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	return c.GetDevicePortGraphWithContext(context.Background(), identifier, ifName, graphType, opts, w)
+}
+
+// GetDevicePortGraphWithContext is like GetDevicePortGraph, but uses the provided context for the request.
+func (c *Client) GetDevicePortGraphWithContext(ctx context.Context, identifier, ifName, graphType string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	if identifier == "" || ifName == "" || graphType == "" {
+		return nil, errors.New("device identifier, port name and graph type are required")
+	}
+	// port names commonly contain slashes, e.g. "Gi0/1"
+	uri := fmt.Sprintf("%s/%s/ports/%s/%s", deviceEndpoint, identifier, url.PathEscape(ifName), url.PathEscape(graphType))
+	return c.getGraph(ctx, uri, opts, w)
+}
+
+// GetMultiPortBitsGraph writes a combined bits graph image of the given ports to w.
+//
+// Documentation: https://docs.librenms.org/API/PortGroups/#get_graph_by_portgroup_multiport_bits
+func (c *Client) GetMultiPortBitsGraph(portIDs []int, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	return c.GetMultiPortBitsGraphWithContext(context.Background(), portIDs, opts, w)
+}
+
+// GetMultiPortBitsGraphWithContext is like GetMultiPortBitsGraph, but uses the provided context for the request.
+func (c *Client) GetMultiPortBitsGraphWithContext(ctx context.Context, portIDs []int, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	if len(portIDs) == 0 {
+		return nil, errors.New("at least one port ID is required")
+	}
+	ids := make([]string, len(portIDs))
+	for i, id := range portIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	uri := fmt.Sprintf("%s/multiport/bits/%s", portGroupGraphEndpoint, strings.Join(ids, ","))
+	return c.getGraph(ctx, uri, opts, w)
+}
+
+// GetPortGroupGraph writes a bits graph image of the ports in a port group to w. The
+// group is the port group name, matched against the ifAlias-based port group descriptions.
+//
+// Documentation: https://docs.librenms.org/API/PortGroups/#get_graph_by_portgroup
+func (c *Client) GetPortGroupGraph(group string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	return c.GetPortGroupGraphWithContext(context.Background(), group, opts, w)
+}
+
+// GetPortGroupGraphWithContext is like GetPortGroupGraph, but uses the provided context for the request.
+func (c *Client) GetPortGroupGraphWithContext(ctx context.Context, group string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	if group == "" {
+		return nil, errors.New("port group is required")
+	}
+	uri := fmt.Sprintf("%s/%s", portGroupGraphEndpoint, url.PathEscape(group))
+	return c.getGraph(ctx, uri, opts, w)
+}
+
+// getGraph requests a graph image and streams it to w after validating the Content-Type.
+func (c *Client) getGraph(ctx context.Context, uri string, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	if w == nil {
+		return nil, errors.New("writer cannot be nil")
+	}
+
+	var params *url.Values
+	if opts != nil {
+		params = opts.values()
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, params)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "image/png, image/svg+xml")
+
+	resp, err := c.rawDo(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	contentType := resp.Header.Get("Content-Type")
+	if err = checkGraphContentType(contentType, opts); err != nil {
+		// the API reports some failures (e.g. unknown graph types) with a 200 and a non-image body
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, graphErrorBodyLimit))
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(string(body)))
+	}
+
+	size, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failure writing graph: %w", err)
+	}
+	return &GraphImage{ContentType: contentType, Size: size}, nil
+}
+
+// checkGraphContentType checks the Content-Type is an image, matching the requested output format if set.
+func checkGraphContentType(contentType string, opts *GraphOptions) error {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "image/") {
+		return fmt.Errorf("unexpected graph content type %q", contentType)
+	}
+
+	if opts != nil && opts.Output != nil {
+		expected := "image/" + *opts.Output
+		if *opts.Output == GraphOutputSVG {
+			expected = "image/svg+xml"
+		}
+		if mediaType != expected {
+			return fmt.Errorf("unexpected graph content type %q, expected %q", contentType, expected)
+		}
+	}
+	return nil
+}
+
+// NewGraphOptions creates a new GraphOptions with no parameters set.
+func NewGraphOptions() *GraphOptions {
+	return &GraphOptions{}
+}
+
+// SetFrom sets the start time of the graph.
+func (o *GraphOptions) SetFrom(from time.Time) *GraphOptions {
+	o.From = &from
+	return o
+}
+
+// SetIfDescr sets whether port graphs identify the port by ifDescr instead of ifName.
+func (o *GraphOptions) SetIfDescr(ifDescr bool) *GraphOptions {
+	o.IfDescr = &ifDescr
+	return o
+}
+
+// SetOutput sets the image format of the graph, GraphOutputPNG or GraphOutputSVG.
+func (o *GraphOptions) SetOutput(output string) *GraphOptions {
+	o.Output = &output
+	return o
+}
+
+// SetRange sets the start time of the graph to the given duration before now, ending now.
+func (o *GraphOptions) SetRange(d time.Duration) *GraphOptions {
+	now := time.Now()
+	return o.SetFrom(now.Add(-d)).SetTo(now)
+}
+
+// SetSize sets the width and height of the graph image in pixels.
+func (o *GraphOptions) SetSize(width, height int) *GraphOptions {
+	o.Width = &width
+	o.Height = &height
+	return o
+}
+
+// SetTo sets the end time of the graph.
+func (o *GraphOptions) SetTo(to time.Time) *GraphOptions {
+	o.To = &to
+	return o
+}
+
+// values generates the actual query payload for the request,
+// only including fields that are not nil.
+func (o *GraphOptions) values() *url.Values {
+	v := &url.Values{}
+	if o.From != nil {
+		v.Set("from", strconv.FormatInt(o.From.Unix(), 10))
+	}
+	if o.To != nil {
+		v.Set("to", strconv.FormatInt(o.To.Unix(), 10))
+	}
+	if o.Width != nil {
+		v.Set("width", strconv.Itoa(*o.Width))
+	}
+	if o.Height != nil {
+		v.Set("height", strconv.Itoa(*o.Height))
+	}
+	if o.Output != nil {
+		// the image format is selected with the graph_type parameter; the API's own
+		// "output" parameter switches to a base64 JSON response, which isn't supported here
+		v.Set("graph_type", *o.Output)
+	}
+	if o.IfDescr != nil {
+		v.Set("ifDescr", strconv.FormatBool(*o.IfDescr))
+	}
+	return v
+}
@@ -0,0 +1,148 @@
+package librenms_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointBillGraph       = "/api/v0/bills/1/graphs/bits"
+	testEndpointDeviceGraph     = "/api/v0/devices/1.1.1.1/device_processor"
+	testEndpointDevicePortGraph = "/api/v0/devices/1.1.1.1/ports/Gi0%2F1/port_bits"
+	testEndpointMultiPortGraph  = "/api/v0/portgroups/multiport/bits/1,2"
+	testEndpointPortGroupGraph  = "/api/v0/portgroups/transit"
+	testEndpointBadGraph        = "/api/v0/devices/1.1.1.1/unknown_graph"
+)
+
+var (
+	testPNG = []byte("\x89PNG\r\n\x1a\nfake-png-data")
+	testSVG = []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`)
+)
+
+// graphHandler returns a handler serving the image with the given content type.
+func graphHandler(contentType string, image []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, err := w.Write(image)
+		handleWriteErr(err, w)
+	}
+}
+
+// This init function will register handlers for graph API endpoints.
+func init() {
+	// Registering these endpoints outside of handleEndpoint() to serve images instead of JSON.
+	mux.HandleFunc(testEndpointBillGraph, graphHandler("image/svg+xml", testSVG))
+	mux.HandleFunc(testEndpointMultiPortGraph, graphHandler("image/png", testPNG))
+	mux.HandleFunc(testEndpointPortGroupGraph, graphHandler("image/png", testPNG))
+	mux.HandleFunc(testEndpointDevicePortGraph, graphHandler("image/png", testPNG))
+
+	mux.HandleFunc(testEndpointDeviceGraph, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("from") != "1748736000" || q.Get("to") != "1748822400" ||
+			q.Get("width") != "800" || q.Get("height") != "300" || q.Get("graph_type") != "png" {
+			http.Error(w, `{"status": "error", "message": "unexpected query"}`, http.StatusBadRequest)
+			return
+		}
+		graphHandler("image/png", testPNG)(w, r)
+	})
+
+	// the API reports some failures with a 200 and a JSON body
+	handleEndpoint(testEndpointBadGraph, mockResponses{
+		http.MethodGet: []byte(`{"status": "error", "message": "Graph type not found"}`),
+	})
+}
+
+func TestClient_GetDeviceGraph(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	opts := librenms.NewGraphOptions().
+		SetFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)).
+		SetTo(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)).
+		SetSize(800, 300).
+		SetOutput(librenms.GraphOutputPNG)
+
+	var buf bytes.Buffer
+	img, err := testAPIClient.GetDeviceGraph("1.1.1.1", "device_processor", opts, &buf)
+
+	r.NoError(err, "GetDeviceGraph returned an error")
+	r.NotNil(img, "GetDeviceGraph image is nil")
+	r.Equal("image/png", img.ContentType, "Unexpected content type")
+	r.Equal(int64(len(testPNG)), img.Size, "Unexpected size")
+	r.Equal(testPNG, buf.Bytes(), "Unexpected image data")
+
+	_, err = testAPIClient.GetDeviceGraph("1.1.1.1", "", nil, &buf)
+	r.Error(err, "Expected error for empty graph type")
+
+	_, err = testAPIClient.GetDeviceGraph("1.1.1.1", "device_processor", nil, nil)
+	r.Error(err, "Expected error for nil writer")
+}
+
+func TestClient_GetDeviceGraph_ContentType(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	var buf bytes.Buffer
+	_, err := testAPIClient.GetDeviceGraph("1.1.1.1", "unknown_graph", nil, &buf)
+
+	r.Error(err, "Expected error for non-image response")
+	r.Contains(err.Error(), "Graph type not found", "Expected error to include the response body")
+	r.Zero(buf.Len(), "Expected nothing written")
+
+	// the content type must match the requested output
+	_, err = testAPIClient.GetBillGraph(1, "bits", librenms.NewGraphOptions().SetOutput(librenms.GraphOutputPNG), &buf)
+	r.Error(err, "Expected error for mismatched content type")
+	r.Zero(buf.Len(), "Expected nothing written")
+}
+
+func TestClient_GetDevicePortGraph(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	var buf bytes.Buffer
+	img, err := testAPIClient.GetDevicePortGraph("1.1.1.1", "Gi0/1", "port_bits", nil, &buf)
+
+	r.NoError(err, "GetDevicePortGraph returned an error")
+	r.Equal("image/png", img.ContentType, "Unexpected content type")
+	r.Equal(testPNG, buf.Bytes(), "Unexpected image data")
+}
+
+func TestClient_GetBillGraph(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	var buf bytes.Buffer
+	img, err := testAPIClient.GetBillGraph(1, "bits", librenms.NewGraphOptions().SetOutput(librenms.GraphOutputSVG), &buf)
+
+	r.NoError(err, "GetBillGraph returned an error")
+	r.Equal("image/svg+xml", img.ContentType, "Unexpected content type")
+	r.Equal(testSVG, buf.Bytes(), "Unexpected image data")
+}
+
+func TestClient_GetPortGroupGraph(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	var buf bytes.Buffer
+	_, err := testAPIClient.GetPortGroupGraph("transit", librenms.NewGraphOptions().SetRange(24*time.Hour), &buf)
+	r.NoError(err, "GetPortGroupGraph returned an error")
+	r.Equal(testPNG, buf.Bytes(), "Unexpected image data")
+
+	buf.Reset()
+	_, err = testAPIClient.GetMultiPortBitsGraph([]int{1, 2}, nil, &buf)
+	r.NoError(err, "GetMultiPortBitsGraph returned an error")
+	r.Equal(testPNG, buf.Bytes(), "Unexpected image data")
+
+	_, err = testAPIClient.GetMultiPortBitsGraph(nil, nil, &buf)
+	r.Error(err, "Expected error for no port IDs")
+}
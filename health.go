@@ -0,0 +1,350 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// sensorEndpoint is the API endpoint for listing all sensors.
+	sensorEndpoint = "resources/sensors"
+)
+
+type (
+	// HealthGraph represents an available health or wireless graph of a device.
+	//
+	// When listing the graphs of a device, Name is the graph type (e.g. "device_temperature")
+	// and SensorID is zero. When listing the graphs of a type, SensorID identifies the sensor
+	// and Name is empty.
+	HealthGraph struct {
+		Name        string `json:"name,omitempty"`
+		Description string `json:"desc"`
+		SensorID    int    `json:"sensor_id,omitempty"`
+	}
+
+	// HealthGraphResponse represents a response containing the available health or wireless graphs of a device.
+	HealthGraphResponse struct {
+		BaseResponse
+		Graphs []HealthGraph `json:"graphs"`
+	}
+
+	// Sensor represents a health sensor (e.g. temperature, voltage or fanspeed) in LibreNMS.
+	//
+	// Current, Previous and the limits may be null if the sensor hasn't been polled or
+	// has no thresholds configured.
+	Sensor struct {
+		ID       int `json:"sensor_id"`
+		DeviceID int `json:"device_id"`
+
+		Alert                    Bool     `json:"sensor_alert"`
+		Class                    string   `json:"sensor_class"` // e.g. temperature, voltage, fanspeed
+		Current                  *Float64 `json:"sensor_current"`
+		Custom                   string   `json:"sensor_custom"` // "Yes" if the limits were set by a user
+		Deleted                  Bool     `json:"sensor_deleted"`
+		Description              string   `json:"sensor_descr"`
+		Divisor                  Float64  `json:"sensor_divisor"`
+		EntPhysicalIndex         *string  `json:"entPhysicalIndex"`
+		EntPhysicalIndexMeasured *string  `json:"entPhysicalIndex_measured"`
+		Group                    *string  `json:"group"`
+		Index                    string   `json:"sensor_index"`
+		LastUpdate               Time     `json:"lastupdate"`
+		LimitHigh                *Float64 `json:"sensor_limit"`
+		LimitHighWarn            *Float64 `json:"sensor_limit_warn"`
+		LimitLow                 *Float64 `json:"sensor_limit_low"`
+		LimitLowWarn             *Float64 `json:"sensor_limit_low_warn"`
+		Multiplier               Float64  `json:"sensor_multiplier"`
+		OID                      string   `json:"sensor_oid"`
+		PollerType               string   `json:"poller_type"`
+		Previous                 *Float64 `json:"sensor_prev"`
+		RRDType                  string   `json:"rrd_type"`
+		Type                     string   `json:"sensor_type"`
+		UserFunc                 *string  `json:"user_func"`
+	}
+
+	// SensorResponse represents a response containing a list of sensors.
+	SensorResponse struct {
+		BaseResponse
+		Sensors []Sensor `json:"sensors"`
+	}
+
+	// WirelessSensor represents a wireless sensor (e.g. clients, snr or frequency) in LibreNMS.
+	WirelessSensor struct {
+		ID       int `json:"sensor_id"`
+		DeviceID int `json:"device_id"`
+
+		AccessPointID            *int     `json:"access_point_id"`
+		Aggregator               string   `json:"sensor_aggregator"` // sum or avg
+		Alert                    Bool     `json:"sensor_alert"`
+		Class                    string   `json:"sensor_class"` // e.g. clients, snr, frequency
+		Current                  *Float64 `json:"sensor_current"`
+		Custom                   string   `json:"sensor_custom"`
+		Deleted                  Bool     `json:"sensor_deleted"`
+		Description              string   `json:"sensor_descr"`
+		Divisor                  Float64  `json:"sensor_divisor"`
+		EntPhysicalIndex         *string  `json:"entPhysicalIndex"`
+		EntPhysicalIndexMeasured *string  `json:"entPhysicalIndex_measured"`
+		Index                    string   `json:"sensor_index"`
+		LastUpdate               Time     `json:"lastupdate"`
+		LimitHigh                *Float64 `json:"sensor_limit"`
+		LimitHighWarn            *Float64 `json:"sensor_limit_warn"`
+		LimitLow                 *Float64 `json:"sensor_limit_low"`
+		LimitLowWarn             *Float64 `json:"sensor_limit_low_warn"`
+		Multiplier               Float64  `json:"sensor_multiplier"`
+		OIDs                     string   `json:"sensor_oids"` // JSON encoded list of OIDs
+		Previous                 *Float64 `json:"sensor_prev"`
+		Type                     string   `json:"sensor_type"`
+	}
+
+	// WirelessSensorResponse represents a response containing a list of wireless sensors.
+	WirelessSensorResponse struct {
+		BaseResponse
+		Sensors []WirelessSensor `json:"sensors"`
+	}
+
+	// healthSensorResponse is the internal response structure for a single health sensor,
+	// which uses the key "graphs". It's normalized into a SensorResponse.
+	healthSensorResponse struct {
+		BaseResponse
+		Graphs []Sensor `json:"graphs"`
+	}
+
+	// wirelessSensorResponse is the internal response structure for a single wireless sensor,
+	// which uses the key "graphs". It's normalized into a WirelessSensorResponse.
+	wirelessSensorResponse struct {
+		BaseResponse
+		Graphs []WirelessSensor `json:"graphs"`
+	}
+)
+
+// GetDeviceHealthGraph writes a health graph image of a device to w. The graph type is e.g.
+// "device_temperature". If sensorID is non-zero, only that sensor is graphed.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_health_graph
+func (c *Client) GetDeviceHealthGraph(identifier, graphType string, sensorID int, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	return c.GetDeviceHealthGraphWithContext(context.Background(), identifier, graphType, sensorID, opts, w)
+}
+
+// GetDeviceHealthGraphWithContext is like GetDeviceHealthGraph, but uses the provided context for the request.
+func (c *Client) GetDeviceHealthGraphWithContext(ctx context.Context, identifier, graphType string, sensorID int, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	if graphType == "" {
+		return nil, errors.New("graph type is required")
+	}
+	uri, err := sensorURI(identifier, "graphs/health", graphType, sensorID)
+	if err != nil {
+		return nil, err
+	}
+	return c.getGraph(ctx, uri, opts, w)
+}
+
+// GetDeviceHealthGraphs retrieves the health graph types available for a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#list_available_health_graphs
+func (c *Client) GetDeviceHealthGraphs(identifier string) (*HealthGraphResponse, error) {
+	return c.GetDeviceHealthGraphsWithContext(context.Background(), identifier)
+}
+
+// GetDeviceHealthGraphsWithContext is like GetDeviceHealthGraphs, but uses the provided context for the request.
+func (c *Client) GetDeviceHealthGraphsWithContext(ctx context.Context, identifier string) (*HealthGraphResponse, error) {
+	return c.getHealthGraphs(ctx, identifier, "health", "")
+}
+
+// GetDeviceHealthSensor retrieves a health sensor of a device by the graph type (e.g.
+// "device_temperature") and sensor ID.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#list_available_health_graphs
+func (c *Client) GetDeviceHealthSensor(identifier, graphType string, sensorID int) (*SensorResponse, error) {
+	return c.GetDeviceHealthSensorWithContext(context.Background(), identifier, graphType, sensorID)
+}
+
+// GetDeviceHealthSensorWithContext is like GetDeviceHealthSensor, but uses the provided context for the request.
+func (c *Client) GetDeviceHealthSensorWithContext(ctx context.Context, identifier, graphType string, sensorID int) (*SensorResponse, error) {
+	if sensorID == 0 {
+		return nil, errors.New("sensor ID is required")
+	}
+	uri, err := sensorURI(identifier, "health", graphType, sensorID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	internalResp := new(healthSensorResponse)
+	if err = c.do(req, internalResp); err != nil {
+		return nil, err
+	}
+
+	return &SensorResponse{
+		BaseResponse: BaseResponse{
+			Status:  internalResp.Status,
+			Message: internalResp.Message,
+			Count:   len(internalResp.Graphs),
+		},
+		Sensors: internalResp.Graphs,
+	}, nil
+}
+
+// GetDeviceHealthSensorGraphs retrieves the per-sensor graphs of a device for a health graph type,
+// e.g. "device_temperature". Each graph identifies a sensor by its ID; use GetDeviceHealthSensor()
+// to retrieve the sensor's values.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#list_available_health_graphs
+func (c *Client) GetDeviceHealthSensorGraphs(identifier, graphType string) (*HealthGraphResponse, error) {
+	return c.GetDeviceHealthSensorGraphsWithContext(context.Background(), identifier, graphType)
+}
+
+// GetDeviceHealthSensorGraphsWithContext is like GetDeviceHealthSensorGraphs, but uses the provided context for the request.
+func (c *Client) GetDeviceHealthSensorGraphsWithContext(ctx context.Context, identifier, graphType string) (*HealthGraphResponse, error) {
+	if graphType == "" {
+		return nil, errors.New("graph type is required")
+	}
+	return c.getHealthGraphs(ctx, identifier, "health", graphType)
+}
+
+// GetDeviceWirelessGraph writes a wireless graph image of a device to w. The graph type is
+// e.g. "device_wireless_clients". If sensorID is non-zero, only that sensor is graphed.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_wireless_graph
+func (c *Client) GetDeviceWirelessGraph(identifier, graphType string, sensorID int, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	return c.GetDeviceWirelessGraphWithContext(context.Background(), identifier, graphType, sensorID, opts, w)
+}
+
+// GetDeviceWirelessGraphWithContext is like GetDeviceWirelessGraph, but uses the provided context for the request.
+func (c *Client) GetDeviceWirelessGraphWithContext(ctx context.Context, identifier, graphType string, sensorID int, opts *GraphOptions, w io.Writer) (*GraphImage, error) {
+	if graphType == "" {
+		return nil, errors.New("graph type is required")
+	}
+	uri, err := sensorURI(identifier, "graphs/wireless", graphType, sensorID)
+	if err != nil {
+		return nil, err
+	}
+	return c.getGraph(ctx, uri, opts, w)
+}
+
+// GetDeviceWirelessGraphs retrieves the wireless graph types available for a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#list_available_wireless_graphs
+func (c *Client) GetDeviceWirelessGraphs(identifier string) (*HealthGraphResponse, error) {
+	return c.GetDeviceWirelessGraphsWithContext(context.Background(), identifier)
+}
+
+// GetDeviceWirelessGraphsWithContext is like GetDeviceWirelessGraphs, but uses the provided context for the request.
+func (c *Client) GetDeviceWirelessGraphsWithContext(ctx context.Context, identifier string) (*HealthGraphResponse, error) {
+	return c.getHealthGraphs(ctx, identifier, "wireless", "")
+}
+
+// GetDeviceWirelessSensor retrieves a wireless sensor of a device by the graph type (e.g.
+// "device_wireless_clients") and sensor ID.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#list_available_wireless_graphs
+func (c *Client) GetDeviceWirelessSensor(identifier, graphType string, sensorID int) (*WirelessSensorResponse, error) {
+	return c.GetDeviceWirelessSensorWithContext(context.Background(), identifier, graphType, sensorID)
+}
+
+// GetDeviceWirelessSensorWithContext is like GetDeviceWirelessSensor, but uses the provided context for the request.
+func (c *Client) GetDeviceWirelessSensorWithContext(ctx context.Context, identifier, graphType string, sensorID int) (*WirelessSensorResponse, error) {
+	if sensorID == 0 {
+		return nil, errors.New("sensor ID is required")
+	}
+	uri, err := sensorURI(identifier, "wireless", graphType, sensorID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	internalResp := new(wirelessSensorResponse)
+	if err = c.do(req, internalResp); err != nil {
+		return nil, err
+	}
+
+	return &WirelessSensorResponse{
+		BaseResponse: BaseResponse{
+			Status:  internalResp.Status,
+			Message: internalResp.Message,
+			Count:   len(internalResp.Graphs),
+		},
+		Sensors: internalResp.Graphs,
+	}, nil
+}
+
+// GetDeviceWirelessSensorGraphs retrieves the per-sensor graphs of a device for a wireless graph type,
+// e.g. "device_wireless_clients". Each graph identifies a sensor by its ID; use GetDeviceWirelessSensor()
+// to retrieve the sensor's values.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#list_available_wireless_graphs
+func (c *Client) GetDeviceWirelessSensorGraphs(identifier, graphType string) (*HealthGraphResponse, error) {
+	return c.GetDeviceWirelessSensorGraphsWithContext(context.Background(), identifier, graphType)
+}
+
+// GetDeviceWirelessSensorGraphsWithContext is like GetDeviceWirelessSensorGraphs, but uses the provided context for the request.
+func (c *Client) GetDeviceWirelessSensorGraphsWithContext(ctx context.Context, identifier, graphType string) (*HealthGraphResponse, error) {
+	if graphType == "" {
+		return nil, errors.New("graph type is required")
+	}
+	return c.getHealthGraphs(ctx, identifier, "wireless", graphType)
+}
+
+// GetSensors retrieves all health sensors across all devices.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#list_sensors
+func (c *Client) GetSensors() (*SensorResponse, error) {
+	return c.GetSensorsWithContext(context.Background())
+}
+
+// GetSensorsWithContext is like GetSensors, but uses the provided context for the request.
+func (c *Client) GetSensorsWithContext(ctx context.Context) (*SensorResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, sensorEndpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SensorResponse)
+	return resp, c.do(req, resp)
+}
+
+// getHealthGraphs lists the health or wireless graphs of a device, optionally for a graph type.
+func (c *Client) getHealthGraphs(ctx context.Context, identifier, kind, graphType string) (*HealthGraphResponse, error) {
+	uri, err := sensorURI(identifier, kind, graphType, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(HealthGraphResponse)
+	return resp, c.do(req, resp)
+}
+
+// sensorURI builds the URI of a device's health or wireless resource, e.g.
+// devices/:hostname/health/:type/:sensor_id. The graph type and sensor ID are optional.
+func sensorURI(identifier, kind, graphType string, sensorID int) (string, error) {
+	if identifier == "" {
+		return "", errors.New("device identifier is required")
+	}
+
+	uri := fmt.Sprintf("%s/%s/%s", deviceEndpoint, identifier, kind)
+	if graphType == "" {
+		if sensorID != 0 {
+			return "", errors.New("graph type is required with a sensor ID")
+		}
+		return uri, nil
+	}
+	uri += "/" + url.PathEscape(graphType)
+	if sensorID != 0 {
+		uri += fmt.Sprintf("/%d", sensorID)
+	}
+	return uri, nil
+}
@@ -0,0 +1,174 @@
+package librenms_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointHealthGraph          = "/api/v0/devices/1.1.1.1/graphs/health/device_temperature/12"
+	testEndpointHealthGraphs         = "/api/v0/devices/1.1.1.1/health"
+	testEndpointHealthSensor         = "/api/v0/devices/1.1.1.1/health/device_temperature/12"
+	testEndpointHealthSensorGraphs   = "/api/v0/devices/1.1.1.1/health/device_temperature"
+	testEndpointSensors              = "/api/v0/resources/sensors"
+	testEndpointWirelessGraph        = "/api/v0/devices/1.1.1.1/graphs/wireless/device_wireless_clients"
+	testEndpointWirelessGraphs       = "/api/v0/devices/1.1.1.1/wireless"
+	testEndpointWirelessSensor       = "/api/v0/devices/1.1.1.1/wireless/device_wireless_clients/7"
+	testEndpointWirelessSensorGraphs = "/api/v0/devices/1.1.1.1/wireless/device_wireless_clients"
+)
+
+// This init function will register handlers for health and wireless API endpoints.
+func init() {
+	handleEndpoint(testEndpointHealthGraphs, mockResponses{
+		http.MethodGet: loadMockResponse("get_health_graphs_200.json"),
+	})
+
+	handleEndpoint(testEndpointHealthSensorGraphs, mockResponses{
+		http.MethodGet: loadMockResponse("get_health_sensors_200.json"),
+	})
+
+	handleEndpoint(testEndpointHealthSensor, mockResponses{
+		http.MethodGet: loadMockResponse("get_health_sensor_200.json"),
+	})
+
+	handleEndpoint(testEndpointSensors, mockResponses{
+		http.MethodGet: loadMockResponse("list_sensors_200.json"),
+	})
+
+	handleEndpoint(testEndpointWirelessGraphs, mockResponses{
+		http.MethodGet: loadMockResponse("get_wireless_graphs_200.json"),
+	})
+
+	// the sensor listing of a type has the same shape for health and wireless graphs
+	handleEndpoint(testEndpointWirelessSensorGraphs, mockResponses{
+		http.MethodGet: loadMockResponse("get_health_sensors_200.json"),
+	})
+
+	handleEndpoint(testEndpointWirelessSensor, mockResponses{
+		http.MethodGet: loadMockResponse("get_wireless_sensor_200.json"),
+	})
+
+	// Registering these endpoints outside of handleEndpoint() to serve images instead of JSON.
+	mux.HandleFunc(testEndpointHealthGraph, graphHandler("image/png", testPNG))
+	mux.HandleFunc(testEndpointWirelessGraph, graphHandler("image/png", testPNG))
+}
+
+func TestClient_GetDeviceHealthGraphs(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceHealthGraphs("1.1.1.1")
+
+	r.NoError(err, "GetDeviceHealthGraphs returned an error")
+	r.NotNil(resp, "GetDeviceHealthGraphs response is nil")
+	r.Len(resp.Graphs, 3, "Expected 3 graphs")
+	r.Equal("device_temperature", resp.Graphs[0].Name, "Unexpected graph name")
+	r.Equal("Temperature", resp.Graphs[0].Description, "Unexpected graph description")
+
+	resp, err = testAPIClient.GetDeviceHealthSensorGraphs("1.1.1.1", "device_temperature")
+
+	r.NoError(err, "GetDeviceHealthSensorGraphs returned an error")
+	r.Len(resp.Graphs, 2, "Expected 2 sensor graphs")
+	r.Equal(12, resp.Graphs[0].SensorID, "Unexpected sensor ID")
+	r.Equal("CPU Temperature", resp.Graphs[0].Description, "Unexpected sensor description")
+
+	_, err = testAPIClient.GetDeviceHealthSensorGraphs("1.1.1.1", "")
+	r.Error(err, "Expected error for empty graph type")
+
+	_, err = testAPIClient.GetDeviceHealthGraphs("")
+	r.Error(err, "Expected error for empty identifier")
+}
+
+func TestClient_GetDeviceHealthSensor(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceHealthSensor("1.1.1.1", "device_temperature", 12)
+
+	r.NoError(err, "GetDeviceHealthSensor returned an error")
+	r.NotNil(resp, "GetDeviceHealthSensor response is nil")
+	r.Equal(1, resp.Count, "Expected count 1")
+	r.Len(resp.Sensors, 1, "Expected 1 sensor")
+
+	sensor := resp.Sensors[0]
+	r.Equal(12, sensor.ID, "Unexpected sensor ID")
+	r.Equal("temperature", sensor.Class, "Unexpected sensor class")
+	r.True(bool(sensor.Alert), "Expected alerting enabled")
+	r.Equal(41.5, float64(*sensor.Current), "Unexpected current value")
+	r.Equal(70.0, float64(*sensor.LimitHigh), "Expected high limit parsed from a string")
+	r.Equal(60.0, float64(*sensor.LimitHighWarn), "Unexpected high warning limit")
+	r.Nil(sensor.LimitLowWarn, "Expected nil low warning limit")
+	r.Equal(time.Date(2025, 6, 1, 22, 0, 0, 0, time.UTC), sensor.LastUpdate.Time, "Unexpected last update")
+
+	_, err = testAPIClient.GetDeviceHealthSensor("1.1.1.1", "device_temperature", 0)
+	r.Error(err, "Expected error for zero sensor ID")
+}
+
+func TestClient_GetSensors(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetSensors()
+
+	r.NoError(err, "GetSensors returned an error")
+	r.NotNil(resp, "GetSensors response is nil")
+	r.Len(resp.Sensors, 2, "Expected 2 sensors")
+	r.Equal("fanspeed", resp.Sensors[1].Class, "Unexpected sensor class")
+	r.Equal(4200.0, float64(*resp.Sensors[1].Current), "Expected current value parsed from a string")
+	r.Nil(resp.Sensors[1].LimitHigh, "Expected nil high limit")
+}
+
+func TestClient_GetDeviceWirelessSensor(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	graphs, err := testAPIClient.GetDeviceWirelessGraphs("1.1.1.1")
+	r.NoError(err, "GetDeviceWirelessGraphs returned an error")
+	r.Len(graphs.Graphs, 1, "Expected 1 graph")
+	r.Equal("device_wireless_clients", graphs.Graphs[0].Name, "Unexpected graph name")
+
+	graphs, err = testAPIClient.GetDeviceWirelessSensorGraphs("1.1.1.1", "device_wireless_clients")
+	r.NoError(err, "GetDeviceWirelessSensorGraphs returned an error")
+	r.Len(graphs.Graphs, 2, "Expected 2 sensors")
+
+	resp, err := testAPIClient.GetDeviceWirelessSensor("1.1.1.1", "device_wireless_clients", 7)
+
+	r.NoError(err, "GetDeviceWirelessSensor returned an error")
+	r.Len(resp.Sensors, 1, "Expected 1 sensor")
+
+	sensor := resp.Sensors[0]
+	r.Equal(7, sensor.ID, "Unexpected sensor ID")
+	r.Equal("sum", sensor.Aggregator, "Unexpected aggregator")
+	r.Equal(23.0, float64(*sensor.Current), "Unexpected current value")
+	r.Nil(sensor.LimitHigh, "Expected nil high limit")
+	r.Nil(sensor.AccessPointID, "Expected nil access point ID")
+}
+
+func TestClient_GetDeviceHealthGraph(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	var buf bytes.Buffer
+	img, err := testAPIClient.GetDeviceHealthGraph("1.1.1.1", "device_temperature", 12, nil, &buf)
+
+	r.NoError(err, "GetDeviceHealthGraph returned an error")
+	r.Equal("image/png", img.ContentType, "Unexpected content type")
+	r.Equal(testPNG, buf.Bytes(), "Unexpected image data")
+
+	buf.Reset()
+	_, err = testAPIClient.GetDeviceWirelessGraph("1.1.1.1", "device_wireless_clients", 0, nil, &buf)
+	r.NoError(err, "GetDeviceWirelessGraph returned an error")
+	r.Equal(testPNG, buf.Bytes(), "Unexpected image data")
+
+	_, err = testAPIClient.GetDeviceHealthGraph("1.1.1.1", "", 12, nil, &buf)
+	r.Error(err, "Expected error for empty graph type")
+}
@@ -0,0 +1,216 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+const (
+	// inventoryEndpoint is the API endpoint for inventory.
+	inventoryEndpoint = "inventory"
+)
+
+type (
+	// InventoryItem represents a physical entity of a device, mirroring the
+	// ENTITY-MIB entPhysicalTable (e.g. a chassis, module, power supply or port).
+	//
+	// Index identifies the item within its device, and ContainedIn is the Index
+	// of its parent, or 0 for top-level items.
+	InventoryItem struct {
+		ID       int `json:"entPhysical_id"`
+		DeviceID int `json:"device_id"`
+
+		Alias        string `json:"entPhysicalAlias"`
+		AssetID      string `json:"entPhysicalAssetID"`
+		Class        string `json:"entPhysicalClass"` // e.g. chassis, module, port, powerSupply, fan, sensor
+		ContainedIn  int    `json:"entPhysicalContainedIn"`
+		Description  string `json:"entPhysicalDescr"`
+		FirmwareRev  string `json:"entPhysicalFirmwareRev"`
+		HardwareRev  string `json:"entPhysicalHardwareRev"`
+		IfIndex      *int   `json:"ifIndex"`
+		Index        int    `json:"entPhysicalIndex"`
+		IsFRU        string `json:"entPhysicalIsFRU"` // "true" or "false"
+		MfgName      string `json:"entPhysicalMfgName"`
+		ModelName    string `json:"entPhysicalModelName"`
+		Name         string `json:"entPhysicalName"`
+		ParentRelPos int    `json:"entPhysicalParentRelPos"`
+		SerialNum    string `json:"entPhysicalSerialNum"`
+		SoftwareRev  string `json:"entPhysicalSoftwareRev"`
+		VendorType   string `json:"entPhysicalVendorType"`
+	}
+
+	// InventoryNode is an inventory item with the items it contains, as assembled by BuildInventoryTree().
+	InventoryNode struct {
+		Item     InventoryItem
+		Children []*InventoryNode
+	}
+
+	// InventoryQuery represents the query parameters for GetInventory().
+	//
+	// ContainedIn is a pointer, as 0 is used to select the top-level items.
+	InventoryQuery struct {
+		Class       string `url:"entPhysicalClass,omitempty"`
+		ContainedIn *int   `url:"entPhysicalContainedIn,omitempty"`
+	}
+
+	// InventoryResponse represents a response containing a list of inventory items.
+	InventoryResponse struct {
+		BaseResponse
+		Inventory []InventoryItem `json:"inventory"`
+	}
+)
+
+// GetInventory retrieves the inventory items of a device by its ID or hostname, optionally
+// filtered by class and parent. Without a query, only the top-level items are returned;
+// use GetInventoryForDevice to retrieve all items.
+//
+// Documentation: https://docs.librenms.org/API/Inventory/#get_inventory
+func (c *Client) GetInventory(identifier string, query *InventoryQuery) (*InventoryResponse, error) {
+	return c.GetInventoryWithContext(context.Background(), identifier, query)
+}
+
+// GetInventoryWithContext is like GetInventory, but uses the provided context for the request.
+func (c *Client) GetInventoryWithContext(ctx context.Context, identifier string, query *InventoryQuery) (*InventoryResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", inventoryEndpoint, identifier), nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(InventoryResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetInventoryForDevice retrieves all inventory items of a device by its ID or hostname.
+// Use BuildInventoryTree() to assemble the items into a tree.
+//
+// Documentation: https://docs.librenms.org/API/Inventory/#get_inventory_for_device
+func (c *Client) GetInventoryForDevice(identifier string) (*InventoryResponse, error) {
+	return c.GetInventoryForDeviceWithContext(context.Background(), identifier)
+}
+
+// GetInventoryForDeviceWithContext is like GetInventoryForDevice, but uses the provided context for the request.
+func (c *Client) GetInventoryForDeviceWithContext(ctx context.Context, identifier string) (*InventoryResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/all", inventoryEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(InventoryResponse)
+	return resp, c.do(req, resp)
+}
+
+// BuildInventoryTree assembles a flat list of a device's inventory items into a tree,
+// using each item's ContainedIn index (e.g. chassis → modules → ports).
+//
+// Items contained in 0, or in an index that isn't in the list, are returned as roots, so
+// no items are lost from a partial inventory. Siblings are ordered by ParentRelPos, then Index.
+// Items with a duplicate Index, or whose containment forms a cycle, are also returned as roots.
+func BuildInventoryTree(items []InventoryItem) []*InventoryNode {
+	nodes := make(map[int]*InventoryNode, len(items))
+	all := make([]*InventoryNode, 0, len(items))
+	var roots []*InventoryNode
+
+	for _, item := range items {
+		node := &InventoryNode{Item: item}
+		all = append(all, node)
+		if _, exists := nodes[item.Index]; exists {
+			roots = append(roots, node)
+			continue
+		}
+		nodes[item.Index] = node
+	}
+
+	for _, node := range all {
+		if nodes[node.Item.Index] != node {
+			continue // duplicate index, already a root
+		}
+		parent, ok := nodes[node.Item.ContainedIn]
+		if !ok || node.Item.ContainedIn == 0 || parent == node || containedIn(parent, node, nodes) {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	sortInventoryNodes(roots)
+	return roots
+}
+
+// containedIn reports whether node is an ancestor of, or is, candidate, following
+// ContainedIn indexes. It's used to avoid attaching a node beneath its own descendant.
+func containedIn(candidate, node *InventoryNode, nodes map[int]*InventoryNode) bool {
+	seen := make(map[int]bool)
+	for current := candidate; current != nil; {
+		if current == node {
+			return true
+		}
+		if seen[current.Item.Index] || current.Item.ContainedIn == 0 {
+			return false
+		}
+		seen[current.Item.Index] = true
+		current = nodes[current.Item.ContainedIn]
+	}
+	return false
+}
+
+// sortInventoryNodes sorts the nodes and their descendants by ParentRelPos, then Index.
+func sortInventoryNodes(nodes []*InventoryNode) {
+	slices.SortStableFunc(nodes, func(a, b *InventoryNode) int {
+		if a.Item.ParentRelPos != b.Item.ParentRelPos {
+			return a.Item.ParentRelPos - b.Item.ParentRelPos
+		}
+		return a.Item.Index - b.Item.Index
+	})
+	for _, node := range nodes {
+		sortInventoryNodes(node.Children)
+	}
+}
+
+// NewInventoryQuery creates a new InventoryQuery with no filters set.
+func NewInventoryQuery() *InventoryQuery {
+	return &InventoryQuery{}
+}
+
+// SetClass filters the inventory items by class, e.g. "module".
+func (q *InventoryQuery) SetClass(class string) *InventoryQuery {
+	q.Class = class
+	return q
+}
+
+// SetContainedIn filters the inventory items by the index of their parent, or 0 for top-level items.
+func (q *InventoryQuery) SetContainedIn(index int) *InventoryQuery {
+	q.ContainedIn = &index
+	return q
+}
+
+// Walk calls fn for the node and each of its descendants in depth-first order, with the
+// depth relative to this node (starting at 0). If fn returns false, the node's children are skipped.
+func (n *InventoryNode) Walk(fn func(node *InventoryNode, depth int) bool) {
+	n.walk(fn, 0)
+}
+
+// walk implements Walk at the given depth.
+func (n *InventoryNode) walk(fn func(node *InventoryNode, depth int) bool, depth int) {
+	if !fn(n, depth) {
+		return
+	}
+	for _, child := range n.Children {
+		child.walk(fn, depth+1)
+	}
+}
@@ -0,0 +1,132 @@
+package librenms_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointInventory    = "/api/v0/inventory/1.1.1.1"
+	testEndpointInventoryAll = "/api/v0/inventory/1.1.1.1/all"
+)
+
+// This init function will register handlers for inventory API endpoints.
+func init() {
+	// Registering this endpoint outside of handleEndpoint() to verify the query parameters.
+	mux.HandleFunc(testEndpointInventory, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("entPhysicalClass") != "chassis" || !q.Has("entPhysicalContainedIn") || q.Get("entPhysicalContainedIn") != "0" {
+			http.Error(w, `{"status": "error", "message": "unexpected query"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(loadMockResponse("get_inventory_200.json"))
+		handleWriteErr(err, w)
+	})
+
+	handleEndpoint(testEndpointInventoryAll, mockResponses{
+		http.MethodGet: loadMockResponse("get_inventory_all_200.json"),
+	})
+}
+
+func TestClient_GetInventory(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	query := librenms.NewInventoryQuery().SetClass("chassis").SetContainedIn(0)
+	resp, err := testAPIClient.GetInventory("1.1.1.1", query)
+
+	r.NoError(err, "GetInventory returned an error")
+	r.NotNil(resp, "GetInventory response is nil")
+	r.Len(resp.Inventory, 1, "Expected 1 inventory item")
+
+	item := resp.Inventory[0]
+	r.Equal(10, item.ID, "Unexpected ID")
+	r.Equal(1, item.Index, "Unexpected index")
+	r.Equal("chassis", item.Class, "Unexpected class")
+	r.Equal("C9300-48P", item.ModelName, "Unexpected model name")
+	r.Equal("FCW0000A1B2", item.SerialNum, "Unexpected serial number")
+	r.Nil(item.IfIndex, "Expected nil ifIndex")
+
+	_, err = testAPIClient.GetInventory("", nil)
+	r.Error(err, "Expected error for empty identifier")
+}
+
+func TestClient_GetInventoryForDevice(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetInventoryForDevice("1.1.1.1")
+
+	r.NoError(err, "GetInventoryForDevice returned an error")
+	r.NotNil(resp, "GetInventoryForDevice response is nil")
+	r.Len(resp.Inventory, 7, "Expected 7 inventory items")
+	r.Equal(8, *resp.Inventory[3].IfIndex, "Unexpected port ifIndex")
+}
+
+func TestBuildInventoryTree(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetInventoryForDevice("1.1.1.1")
+	r.NoError(err, "GetInventoryForDevice returned an error")
+
+	roots := librenms.BuildInventoryTree(resp.Inventory)
+	r.Len(roots, 2, "Expected the chassis and the orphaned sensor as roots")
+	r.Equal("Chassis", roots[0].Item.Name, "Expected the chassis first")
+	r.Equal("Orphaned Sensor", roots[1].Item.Name, "Expected the orphan to be kept as a root")
+
+	// chassis → modules (ordered by relative position) → ports
+	var lines []string
+	roots[0].Walk(func(node *librenms.InventoryNode, depth int) bool {
+		lines = append(lines, strings.Repeat("  ", depth)+node.Item.Name)
+		return true
+	})
+	r.Equal([]string{
+		"Chassis",
+		"  Switch 1 - Main Board",
+		"    GigabitEthernet1/0/1",
+		"  Switch 1 - Uplink Module",
+		"    TenGigabitEthernet1/1/1",
+		"  Power Supply A",
+	}, lines, "Unexpected inventory tree")
+
+	// skipping children
+	count := 0
+	roots[0].Walk(func(node *librenms.InventoryNode, depth int) bool {
+		count++
+		return depth == 0
+	})
+	r.Equal(4, count, "Expected only the chassis and its direct children")
+}
+
+func TestBuildInventoryTree_Cycle(t *testing.T) {
+	r := require.New(t)
+
+	items := []librenms.InventoryItem{
+		{Index: 1, ContainedIn: 2, Name: "a"},
+		{Index: 2, ContainedIn: 1, Name: "b"},
+		{Index: 3, ContainedIn: 3, Name: "self"},
+		{Index: 4, ContainedIn: 1, Name: "child"},
+	}
+
+	roots := librenms.BuildInventoryTree(items)
+
+	total := 0
+	for _, root := range roots {
+		root.Walk(func(*librenms.InventoryNode, int) bool {
+			total++
+			return true
+		})
+	}
+	r.Equal(len(items), total, "Expected every item exactly once")
+	r.Len(roots, 3, "Expected the cyclic and self-contained items as roots")
+	r.Len(roots[0].Children, 1, "Expected the child to be attached to its parent")
+}
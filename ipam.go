@@ -0,0 +1,329 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strconv"
+)
+
+const (
+	// AddressFamilyIPv4 selects IPv4 addresses or networks.
+	AddressFamilyIPv4 = "ipv4"
+	// AddressFamilyIPv6 selects IPv6 addresses or networks.
+	AddressFamilyIPv6 = "ipv6"
+
+	ipAddressEndpoint = "resources/ip/addresses"
+	ipNetworkEndpoint = "resources/ip/networks"
+)
+
+type (
+	// IPAddressOwner represents the device and port an IP address is assigned to, as returned by LookupIPAddress().
+	IPAddressOwner struct {
+		Address PortIPAddress
+		Device  Device
+		Port    Port
+	}
+
+	// IPNetwork represents an IPv4 or IPv6 network discovered on a device port.
+	//
+	// Only the fields for the matching address family are set. Use Prefix() to parse the network.
+	IPNetwork struct {
+		ContextName *string `json:"context_name"`
+
+		IPv4NetworkID *int    `json:"ipv4_network_id"`
+		IPv4Network   *string `json:"ipv4_network"` // e.g. "192.168.1.0/24"
+
+		IPv6NetworkID *int    `json:"ipv6_network_id"`
+		IPv6Network   *string `json:"ipv6_network"` // e.g. "2001:db8::/64"
+	}
+
+	// IPNetworkResponse represents a response containing a list of IP networks.
+	IPNetworkResponse struct {
+		BaseResponse
+		Networks []IPNetwork `json:"ip_networks"`
+	}
+
+	// ipAddressListResponse is the internal response structure for the IP address listing, which
+	// uses the key "ip_addresses" rather than "addresses". It's normalized into a PortIPResponse.
+	ipAddressListResponse struct {
+		BaseResponse
+		Addresses []PortIPAddress `json:"ip_addresses"`
+	}
+)
+
+// GetDeviceIPAddresses retrieves the IP addresses of all ports of a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Devices/#get_device_ip_addresses
+func (c *Client) GetDeviceIPAddresses(identifier string) (*PortIPResponse, error) {
+	return c.GetDeviceIPAddressesWithContext(context.Background(), identifier)
+}
+
+// GetDeviceIPAddressesWithContext is like GetDeviceIPAddresses, but uses the provided context for the request.
+func (c *Client) GetDeviceIPAddressesWithContext(ctx context.Context, identifier string) (*PortIPResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/ip", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortIPResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetIPAddresses retrieves the IP addresses of all devices. The address family is
+// AddressFamilyIPv4, AddressFamilyIPv6 or empty for both.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_ip_addresses
+func (c *Client) GetIPAddresses(family string) (*PortIPResponse, error) {
+	return c.GetIPAddressesWithContext(context.Background(), family)
+}
+
+// GetIPAddressesWithContext is like GetIPAddresses, but uses the provided context for the request.
+func (c *Client) GetIPAddressesWithContext(ctx context.Context, family string) (*PortIPResponse, error) {
+	uri, err := addressFamilyURI(ipAddressEndpoint, family)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	internalResp := new(ipAddressListResponse)
+	if err = c.do(req, internalResp); err != nil {
+		return nil, err
+	}
+
+	return &PortIPResponse{
+		BaseResponse: BaseResponse{
+			Status:  internalResp.Status,
+			Message: internalResp.Message,
+			Count:   len(internalResp.Addresses),
+		},
+		Addresses: internalResp.Addresses,
+	}, nil
+}
+
+// GetIPNetworks retrieves the IP networks of all devices. The address family is
+// AddressFamilyIPv4, AddressFamilyIPv6 or empty for both.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_ip_networks
+func (c *Client) GetIPNetworks(family string) (*IPNetworkResponse, error) {
+	return c.GetIPNetworksWithContext(context.Background(), family)
+}
+
+// GetIPNetworksWithContext is like GetIPNetworks, but uses the provided context for the request.
+func (c *Client) GetIPNetworksWithContext(ctx context.Context, family string) (*IPNetworkResponse, error) {
+	uri, err := addressFamilyURI(ipNetworkEndpoint, family)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(IPNetworkResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetNetworkIPAddresses retrieves the IP addresses within an IP network by its network ID.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#get_network_ip_addresses
+func (c *Client) GetNetworkIPAddresses(networkID int) (*PortIPResponse, error) {
+	return c.GetNetworkIPAddressesWithContext(context.Background(), networkID)
+}
+
+// GetNetworkIPAddressesWithContext is like GetNetworkIPAddresses, but uses the provided context for the request.
+func (c *Client) GetNetworkIPAddressesWithContext(ctx context.Context, networkID int) (*PortIPResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d/ip", ipNetworkEndpoint, networkID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortIPResponse)
+	return resp, c.do(req, resp)
+}
+
+// LookupIPAddress finds the devices and ports an IP address is assigned to. The same address
+// may be assigned more than once, e.g. in different VRFs or as an anycast address.
+// An empty slice is returned if the address isn't known to LibreNMS.
+//
+// This lists all addresses of the address family, and then retrieves the port and device of
+// each match, so it's relatively expensive on large installations.
+func (c *Client) LookupIPAddress(addr netip.Addr) ([]IPAddressOwner, error) {
+	return c.LookupIPAddressWithContext(context.Background(), addr)
+}
+
+// LookupIPAddressWithContext is like LookupIPAddress, but uses the provided context for the request.
+func (c *Client) LookupIPAddressWithContext(ctx context.Context, addr netip.Addr) ([]IPAddressOwner, error) {
+	if !addr.IsValid() {
+		return nil, errors.New("IP address is required")
+	}
+	addr = addr.Unmap()
+
+	family := AddressFamilyIPv6
+	if addr.Is4() {
+		family = AddressFamilyIPv4
+	}
+	resp, err := c.GetIPAddressesWithContext(ctx, family)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make([]IPAddressOwner, 0)
+	devices := make(map[int]Device)
+	for _, address := range resp.Addresses {
+		// addresses the API returns in an unexpected format can't match, so they're skipped
+		if parsed, err := address.Addr(); err != nil || parsed != addr {
+			continue
+		}
+
+		portResp, err := c.GetPortWithContext(ctx, address.PortID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get port %d: %w", address.PortID, err)
+		}
+		if len(portResp.Ports) == 0 {
+			return nil, fmt.Errorf("%w: port %d", ErrNotFound, address.PortID)
+		}
+		port := portResp.Ports[0]
+
+		device, ok := devices[port.DeviceID]
+		if !ok {
+			deviceResp, err := c.GetDeviceWithContext(ctx, strconv.Itoa(port.DeviceID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get device %d: %w", port.DeviceID, err)
+			}
+			if len(deviceResp.Devices) == 0 {
+				return nil, fmt.Errorf("%w: device %d", ErrNotFound, port.DeviceID)
+			}
+			device = deviceResp.Devices[0]
+			devices[port.DeviceID] = device
+		}
+
+		owners = append(owners, IPAddressOwner{Address: address, Device: device, Port: port})
+	}
+	return owners, nil
+}
+
+// LookupIPNetworks finds the IP networks containing an IP address, e.g. to find the subnet
+// an unassigned address belongs to. An empty slice is returned if no network contains it.
+func (c *Client) LookupIPNetworks(addr netip.Addr) ([]IPNetwork, error) {
+	return c.LookupIPNetworksWithContext(context.Background(), addr)
+}
+
+// LookupIPNetworksWithContext is like LookupIPNetworks, but uses the provided context for the request.
+func (c *Client) LookupIPNetworksWithContext(ctx context.Context, addr netip.Addr) ([]IPNetwork, error) {
+	if !addr.IsValid() {
+		return nil, errors.New("IP address is required")
+	}
+	addr = addr.Unmap()
+
+	family := AddressFamilyIPv6
+	if addr.Is4() {
+		family = AddressFamilyIPv4
+	}
+	resp, err := c.GetIPNetworksWithContext(ctx, family)
+	if err != nil {
+		return nil, err
+	}
+
+	networks := make([]IPNetwork, 0)
+	for _, network := range resp.Networks {
+		if prefix, err := network.Prefix(); err == nil && prefix.Contains(addr) {
+			networks = append(networks, network)
+		}
+	}
+	return networks, nil
+}
+
+// Addr parses the IPv4 or IPv6 address.
+func (a *PortIPAddress) Addr() (netip.Addr, error) {
+	var value *string
+	switch {
+	case a.IPv4Address != nil:
+		value = a.IPv4Address
+	case a.IPv6Compressed != nil:
+		value = a.IPv6Compressed
+	case a.IPv6Address != nil:
+		value = a.IPv6Address
+	default:
+		return netip.Addr{}, errors.New("no IP address set")
+	}
+
+	addr, err := netip.ParseAddr(*value)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid IP address %q: %w", *value, err)
+	}
+	return addr, nil
+}
+
+// Prefix parses the IPv4 or IPv6 address with its prefix length, e.g. "192.168.1.10/24".
+// Use Prefix().Masked() for the network the address belongs to.
+func (a *PortIPAddress) Prefix() (netip.Prefix, error) {
+	addr, err := a.Addr()
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefixLen := a.IPv6PrefixLen
+	if addr.Is4() {
+		prefixLen = a.IPv4PrefixLen
+	}
+	if prefixLen == nil {
+		return netip.Prefix{}, fmt.Errorf("no prefix length set for IP address %s", addr)
+	}
+
+	prefix := netip.PrefixFrom(addr, *prefixLen)
+	if !prefix.IsValid() {
+		return netip.Prefix{}, fmt.Errorf("invalid prefix length %d for IP address %s", *prefixLen, addr)
+	}
+	return prefix, nil
+}
+
+// ID returns the IPv4 or IPv6 network ID, or 0 if none is set.
+func (n *IPNetwork) ID() int {
+	switch {
+	case n.IPv4NetworkID != nil:
+		return *n.IPv4NetworkID
+	case n.IPv6NetworkID != nil:
+		return *n.IPv6NetworkID
+	}
+	return 0
+}
+
+// Prefix parses the IPv4 or IPv6 network.
+func (n *IPNetwork) Prefix() (netip.Prefix, error) {
+	value := n.IPv4Network
+	if value == nil {
+		value = n.IPv6Network
+	}
+	if value == nil {
+		return netip.Prefix{}, errors.New("no IP network set")
+	}
+
+	prefix, err := netip.ParsePrefix(*value)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid IP network %q: %w", *value, err)
+	}
+	return prefix, nil
+}
+
+// addressFamilyURI appends the address family to the endpoint, if set.
+func addressFamilyURI(endpoint, family string) (string, error) {
+	switch family {
+	case "":
+		return endpoint, nil
+	case AddressFamilyIPv4, AddressFamilyIPv6:
+		return fmt.Sprintf("%s/%s", endpoint, family), nil
+	}
+	return "", fmt.Errorf("invalid address family %q, expected %q or %q", family, AddressFamilyIPv4, AddressFamilyIPv6)
+}
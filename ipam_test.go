@@ -0,0 +1,147 @@
+package librenms_test
+
+import (
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointDeviceByID         = "/api/v0/devices/1"
+	testEndpointDeviceIP           = "/api/v0/devices/1.1.1.1/ip"
+	testEndpointIPAddressesIPv4    = "/api/v0/resources/ip/addresses/ipv4"
+	testEndpointIPAddressesIPv6    = "/api/v0/resources/ip/addresses/ipv6"
+	testEndpointIPNetworkAddresses = "/api/v0/resources/ip/networks/1/ip"
+	testEndpointIPNetworksIPv4     = "/api/v0/resources/ip/networks/ipv4"
+)
+
+// This init function will register handlers for IP address and network API endpoints.
+func init() {
+	handleEndpoint(testEndpointDeviceByID, mockResponses{
+		http.MethodGet: loadMockResponse("get_device_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceIP, mockResponses{
+		http.MethodGet: loadMockResponse("get_port_ip_200.json"),
+	})
+
+	handleEndpoint(testEndpointIPAddressesIPv4, mockResponses{
+		http.MethodGet: loadMockResponse("list_ip_addresses_ipv4_200.json"),
+	})
+
+	handleEndpoint(testEndpointIPAddressesIPv6, mockResponses{
+		http.MethodGet: loadMockResponse("list_ip_addresses_ipv6_200.json"),
+	})
+
+	handleEndpoint(testEndpointIPNetworkAddresses, mockResponses{
+		http.MethodGet: loadMockResponse("get_network_ip_addresses_200.json"),
+	})
+
+	handleEndpoint(testEndpointIPNetworksIPv4, mockResponses{
+		http.MethodGet: loadMockResponse("list_ip_networks_ipv4_200.json"),
+	})
+}
+
+func TestClient_GetIPAddresses(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetIPAddresses(librenms.AddressFamilyIPv4)
+
+	r.NoError(err, "GetIPAddresses returned an error")
+	r.NotNil(resp, "GetIPAddresses response is nil")
+	r.Equal(2, resp.Count, "Expected count 2")
+	r.Len(resp.Addresses, 2, "Expected 2 IP addresses")
+
+	prefix, err := resp.Addresses[0].Prefix()
+	r.NoError(err, "Prefix returned an error")
+	r.Equal(netip.MustParsePrefix("192.168.1.10/24"), prefix, "Unexpected prefix")
+	r.Equal(netip.MustParsePrefix("192.168.1.0/24"), prefix.Masked(), "Unexpected network")
+
+	resp, err = testAPIClient.GetIPAddresses(librenms.AddressFamilyIPv6)
+	r.NoError(err, "GetIPAddresses returned an error for IPv6")
+
+	prefix, err = resp.Addresses[0].Prefix()
+	r.NoError(err, "Prefix returned an error for IPv6")
+	r.Equal(netip.MustParsePrefix("2001:db8::1/64"), prefix, "Unexpected IPv6 prefix")
+
+	_, err = testAPIClient.GetIPAddresses("ipv5")
+	r.Error(err, "Expected error for invalid address family")
+}
+
+func TestClient_GetDeviceIPAddresses(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceIPAddresses("1.1.1.1")
+
+	r.NoError(err, "GetDeviceIPAddresses returned an error")
+	r.Len(resp.Addresses, 2, "Expected 2 IP addresses")
+
+	// the expanded IPv6 address is parsed if the compressed form is missing
+	address := resp.Addresses[1]
+	address.IPv6Compressed = nil
+	addr, err := address.Addr()
+	r.NoError(err, "Addr returned an error")
+	r.Equal(netip.MustParseAddr("fe80::5054:ff:fe12:3456"), addr, "Unexpected IPv6 address")
+
+	resp, err = testAPIClient.GetNetworkIPAddresses(1)
+	r.NoError(err, "GetNetworkIPAddresses returned an error")
+	r.Len(resp.Addresses, 1, "Expected 1 IP address")
+}
+
+func TestClient_GetIPNetworks(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetIPNetworks(librenms.AddressFamilyIPv4)
+
+	r.NoError(err, "GetIPNetworks returned an error")
+	r.Len(resp.Networks, 2, "Expected 2 IP networks")
+	r.Equal(3, resp.Networks[1].ID(), "Unexpected network ID")
+
+	prefix, err := resp.Networks[1].Prefix()
+	r.NoError(err, "Prefix returned an error")
+	r.Equal(netip.MustParsePrefix("10.0.0.0/30"), prefix, "Unexpected network")
+
+	networks, err := testAPIClient.LookupIPNetworks(netip.MustParseAddr("192.168.1.200"))
+	r.NoError(err, "LookupIPNetworks returned an error")
+	r.Len(networks, 1, "Expected 1 matching network")
+	r.Equal(1, networks[0].ID(), "Unexpected matching network")
+
+	networks, err = testAPIClient.LookupIPNetworks(netip.MustParseAddr("172.16.0.1"))
+	r.NoError(err, "LookupIPNetworks returned an error for an unknown address")
+	r.Empty(networks, "Expected no matching networks")
+}
+
+func TestClient_LookupIPAddress(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	// IPv4-mapped IPv6 addresses are looked up as IPv4
+	owners, err := testAPIClient.LookupIPAddress(netip.MustParseAddr("::ffff:192.168.1.10"))
+
+	r.NoError(err, "LookupIPAddress returned an error")
+	r.Len(owners, 1, "Expected 1 owner")
+	r.Equal(2, owners[0].Port.PortID, "Unexpected port ID")
+	r.Equal(1, owners[0].Device.DeviceID, "Unexpected device ID")
+	r.Equal("1.1.1.1", owners[0].Device.Hostname, "Unexpected device hostname")
+
+	owners, err = testAPIClient.LookupIPAddress(netip.MustParseAddr("2001:db8::1"))
+	r.NoError(err, "LookupIPAddress returned an error for IPv6")
+	r.Len(owners, 1, "Expected 1 owner for IPv6")
+
+	owners, err = testAPIClient.LookupIPAddress(netip.MustParseAddr("172.16.0.1"))
+	r.NoError(err, "LookupIPAddress returned an error for an unknown address")
+	r.Empty(owners, "Expected no owners")
+
+	_, err = testAPIClient.LookupIPAddress(netip.Addr{})
+	r.Error(err, "Expected error for invalid address")
+}
@@ -6,8 +6,12 @@
 //   - Devices
 //   - Device Groups
 //   - Locations
+//   - Ports
 //   - Services
 //
+// Every method has a WithContext variant (e.g. GetDeviceWithContext) which accepts a
+// context.Context for cancellation and deadlines. The plain methods use context.Background().
+//
 // LibreNMS API Documentation: https://docs.librenms.org/API/
 package librenms
 
@@ -25,6 +29,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/hashicorp/go-cleanhttp"
@@ -33,6 +38,9 @@ import (
 const (
 	apiVersion = "v0"
 	authHeader = "X-Auth-Token"
+
+	// timeLayout is the layout of timestamps returned by the API.
+	timeLayout = "2006-01-02 15:04:05"
 )
 
 type (
@@ -44,11 +52,20 @@ type (
 	// returns some fields as strings instead of numbers, so we use this custom type.
 	Float64 float64
 
+	// Time represents a timestamp, used for JSON marshaling. The API returns timestamps as
+	// 'Y-m-d H:i:s' strings (in the timezone of the LibreNMS server) rather than RFC 3339,
+	// so we use this custom type. Timestamps without a timezone are parsed as UTC.
+	// Empty and null values unmarshal to the zero time.
+	Time struct {
+		time.Time
+	}
+
 	// Client is the main structure for the LibreNMS client.
 	Client struct {
 		baseURL *url.URL
 		client  *http.Client
 		log     *slog.Logger
+		retry   *RetryPolicy
 		token   string
 	}
 
@@ -132,9 +149,13 @@ func New(baseURL, token string, opts ...Option) (*Client, error) {
 	return c, nil
 }
 
-// newRequest creates a new HTTP request with the given method and path.
+// newRequest creates a new HTTP request with the given context, method and path.
 // A relative URI should be provided and should not have a leading slash.
-func (c *Client) newRequest(method, uri string, body any, query *url.Values) (*http.Request, error) {
+func (c *Client) newRequest(ctx context.Context, method, uri string, body any, query *url.Values) (*http.Request, error) {
+	if ctx == nil {
+		return nil, errors.New("context cannot be nil")
+	}
+
 	var buf io.ReadWriter
 	if body != nil {
 		buf = &bytes.Buffer{}
@@ -144,7 +165,6 @@ func (c *Client) newRequest(method, uri string, body any, query *url.Values) (*h
 			return nil, err
 		}
 	}
-	ctx := context.Background()
 
 	// Parse the URI and construct the full URL
 	fullURL, err := c.baseURL.Parse(uri)
@@ -178,14 +198,54 @@ func (c *Client) newRequest(method, uri string, body any, query *url.Values) (*h
 // rawDo sends an HTTP request and returns the raw response body. We should normally
 // use do() which JSON-decodes and closes the response body, but if there is a non-JSON
 // endpoint or other reason to not decode, this can be used.
+//
+// The request is bound to the context it was created with in newRequest(), so
+// cancellation and deadlines are handled by the underlying http.Client.
 func (c *Client) rawDo(req *http.Request) (*http.Response, error) {
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
+	for attempt := 1; ; attempt++ {
+		resp, err := c.client.Do(req)
+		if err == nil {
+			c.log.LogAttrs(req.Context(), slog.LevelDebug, "http response", logResponseAttr(resp))
+			err = checkResponse(resp)
+		}
+
+		retrying := c.retry.shouldRetry(req, resp, err, attempt)
+		var wait time.Duration
+		if retrying {
+			wait = c.retry.backoff(attempt, resp)
+		}
+		if c.retry != nil && c.retry.OnAttempt != nil {
+			c.retry.OnAttempt(RetryAttempt{
+				Attempt:  attempt,
+				Request:  req,
+				Response: resp,
+				Err:      err,
+				Retrying: retrying,
+				Wait:     wait,
+			})
+		}
+
+		if !retrying {
+			return resp, err
+		}
+
+		c.log.LogAttrs(req.Context(), slog.LevelDebug, "retrying http request",
+			logRequestAttr(req),
+			slog.Int("attempt", attempt),
+			slog.Duration("wait", wait),
+			slog.Any("error", err),
+		)
+		if resp != nil {
+			closeBody(resp.Body)
+		}
 
-	c.log.LogAttrs(context.Background(), slog.LevelDebug, "http response", logResponseAttr(resp))
-	return resp, checkResponse(resp)
+		if sleepErr := sleep(req.Context(), wait); sleepErr != nil {
+			return nil, sleepErr
+		}
+		if req, err = rewindRequest(req); err != nil {
+			return nil, fmt.Errorf("failed to rewind request body: %w", err)
+		}
+	}
 }
 
 // do sends an HTTP request and decodes the JSON response into the provided response object.
@@ -217,28 +277,29 @@ func (c *Client) do(req *http.Request, respObj any) error {
 }
 
 // checkResponse checks the HTTP response for errors.
+//
+// The body of an error response is consumed and closed, and its contents are
+// captured in the returned ErrorResponse.
 func checkResponse(resp *http.Response) error {
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		errorResponse := &ErrorResponse{
-			Response: resp,
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			errorResponse.Message = fmt.Sprintf("failed to read response body: %v", err)
-			defer closeBody(resp.Body)
-			return errorResponse
-		}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	defer closeBody(resp.Body)
 
-		if len(body) > 0 {
-			if err = json.Unmarshal(body, errorResponse); err != nil {
-				errorResponse.Message = string(body)
-			}
-		}
+	body, err := io.ReadAll(resp.Body)
+	errorResponse := newErrorResponse(resp, body)
+	if err != nil {
+		errorResponse.Message = fmt.Sprintf("failed to read response body: %v", err)
+	}
+	return errorResponse
+}
 
-		return errorResponse
+// boolToInt converts a boolean to the 0/1 integer encoding used by the API.
+func boolToInt(b bool) int {
+	if b {
+		return 1
 	}
-	return nil
+	return 0
 }
 
 func closeBody(body io.ReadCloser) {
@@ -312,3 +373,38 @@ func (f *Float64) UnmarshalJSON(data []byte) error {
 	*f = Float64(value)
 	return nil
 }
+
+// MarshalJSON implements the JSON marshaling for the Time type.
+func (t *Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(timeLayout))
+}
+
+// UnmarshalJSON implements the JSON unmarshalling for the Time type.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	// attempt to unmarshal as a UNIX timestamp first
+	var valueInt int64
+	if err := json.Unmarshal(data, &valueInt); err == nil {
+		t.Time = time.Unix(valueInt, 0).UTC()
+		return nil
+	}
+
+	var valueString *string
+	if err := json.Unmarshal(data, &valueString); err != nil {
+		return fmt.Errorf("failed to unmarshal Time: %w", err)
+	}
+	if valueString == nil || *valueString == "" || strings.HasPrefix(*valueString, "0000-00-00") {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range []string{timeLayout, time.RFC3339Nano, time.DateOnly} {
+		if value, err := time.Parse(layout, *valueString); err == nil {
+			t.Time = value
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to parse Time from string: %q", *valueString)
+}
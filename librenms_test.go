@@ -1,6 +1,7 @@
 package librenms_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -132,3 +133,16 @@ func TestClient_ConnectionRefused(t *testing.T) {
 	r.Error(err, "Expected error when using client with unresponsive host")
 	r.ErrorContains(err, "connection refused", "Expected connection refused error")
 }
+
+func TestClient_ContextCanceled(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := testAPIClient.GetDeviceWithContext(ctx, "1.1.1.1")
+	r.Error(err, "Expected error when using a canceled context")
+	r.ErrorIs(err, context.Canceled, "Expected context canceled error")
+}
@@ -0,0 +1,177 @@
+package librenms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type (
+	// listConfig describes how to stream a list endpoint's response.
+	listConfig struct {
+		// key is the JSON key of the array in the response object, e.g. "devices".
+		key string
+		// nested indicates the array contains arrays of items (e.g. services).
+		nested bool
+		// pageSize enables paging when > 0, using limitParam and offsetParam,
+		// starting at the given offset.
+		pageSize    int
+		limitParam  string
+		offsetParam string
+		offset      int
+	}
+)
+
+// listSeq returns an iterator over the items of a list endpoint. The response array is
+// decoded one item at a time, so the full list is never held in memory.
+//
+// If the config enables paging, subsequent pages are requested until a short page is returned.
+// Iteration stops after the first error, which is yielded with the zero value of T.
+func listSeq[T any](ctx context.Context, c *Client, uri string, params *url.Values, cfg listConfig) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		offset := cfg.offset
+		for {
+			pageParams := url.Values{}
+			if params != nil {
+				for k, v := range *params {
+					pageParams[k] = v
+				}
+			}
+			if cfg.pageSize > 0 {
+				pageParams.Set(cfg.limitParam, strconv.Itoa(cfg.pageSize))
+				pageParams.Set(cfg.offsetParam, strconv.Itoa(offset))
+			}
+
+			req, err := c.newRequest(ctx, http.MethodGet, uri, nil, &pageParams)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			resp, err := c.rawDo(req)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			n, ok := decodeList(resp.Body, cfg, yield)
+			closeBody(resp.Body)
+			if !ok || cfg.pageSize == 0 || n < cfg.pageSize {
+				return
+			}
+			offset += n
+		}
+	}
+}
+
+// decodeList streams the items of the configured array in the JSON object read from r
+// to yield. It returns the number of items yielded, and false if iteration should stop
+// (either because yield returned false or an error occurred).
+func decodeList[T any](r io.Reader, cfg listConfig, yield func(T, error) bool) (int, bool) {
+	var zero T
+	count := 0
+	dec := json.NewDecoder(r)
+
+	fail := func(err error) (int, bool) {
+		yield(zero, fmt.Errorf("failure decoding response: %w", err))
+		return count, false
+	}
+
+	// decodeItems decodes the items of an array whose opening delimiter has been consumed.
+	var decodeItems func(nested bool) (bool, error)
+	decodeItems = func(nested bool) (bool, error) {
+		for dec.More() {
+			if nested {
+				if err := expectDelim(dec, '['); err != nil {
+					return false, err
+				}
+				if ok, err := decodeItems(false); !ok || err != nil {
+					return ok, err
+				}
+				continue
+			}
+
+			var item T
+			if err := dec.Decode(&item); err != nil {
+				return false, err
+			}
+			count++
+			if !yield(item, nil) {
+				return false, nil
+			}
+		}
+		// consume the closing delimiter
+		_, err := dec.Token()
+		return err == nil, err
+	}
+
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return count, true // no content
+	}
+	if err != nil {
+		return fail(err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fail(fmt.Errorf("expected JSON object, got %v", tok))
+	}
+
+	for dec.More() {
+		tok, err = dec.Token()
+		if err != nil {
+			return fail(err)
+		}
+		if key, _ := tok.(string); key != cfg.key {
+			var skip json.RawMessage
+			if err = dec.Decode(&skip); err != nil {
+				return fail(err)
+			}
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return fail(err)
+		}
+		if tok == nil {
+			return count, true // null list
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return fail(fmt.Errorf("expected JSON array for %q, got %v", cfg.key, tok))
+		}
+
+		ok, err := decodeItems(cfg.nested)
+		if err != nil {
+			return fail(err)
+		}
+		return count, ok
+	}
+	return count, true
+}
+
+// expectDelim reads the next token and checks it is the given delimiter.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected %v, got %v", delim, tok)
+	}
+	return nil
+}
+
+// errSeq returns an iterator that yields a single error.
+func errSeq[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
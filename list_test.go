@@ -0,0 +1,116 @@
+package librenms_test
+
+import (
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Devices(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	var devices []librenms.Device
+	for device, err := range testAPIClient.Devices(t.Context(), nil) {
+		r.NoError(err, "Devices returned an error")
+		devices = append(devices, device)
+	}
+
+	r.Len(devices, 3, "Expected 3 devices")
+	r.Equal(1, devices[0].DeviceID, "Expected DeviceID 1")
+	r.Equal("1.1.1.1", devices[0].Hostname, "Expected Hostname '1.1.1.1'")
+	r.Equal(-45.08624620, float64(*devices[2].Latitude), "Expected Latitude -45.0862462")
+}
+
+func TestClient_Devices_Break(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	count := 0
+	for _, err := range testAPIClient.Devices(t.Context(), nil) {
+		r.NoError(err, "Devices returned an error")
+		count++
+		break
+	}
+	r.Equal(1, count, "Expected iteration to stop after break")
+}
+
+func TestClient_Devices_Error(t *testing.T) {
+	r := require.New(t)
+
+	client, err := librenms.New("http://localhost:48325/", "test-token")
+	r.NoError(err, "Expected no error when creating client with unresponsive host")
+
+	count := 0
+	for _, err := range client.Devices(t.Context(), nil) {
+		r.Error(err, "Expected error when using client with unresponsive host")
+		count++
+	}
+	r.Equal(1, count, "Expected a single error to be yielded")
+}
+
+func TestClient_Services(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	var services []librenms.Service
+	for service, err := range testAPIClient.Services(t.Context()) {
+		r.NoError(err, "Services returned an error")
+		services = append(services, service)
+	}
+
+	r.Len(services, 3, "Expected 3 services")
+	r.Equal("check https cert", services[0].Name, "Expected Service name 'check https cert'")
+}
+
+func TestClient_AlertsIter(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	expected, err := testAPIClient.GetAlerts(nil)
+	r.NoError(err, "GetAlerts returned an error")
+
+	var alerts []librenms.Alert
+	for alert, err := range testAPIClient.Alerts(t.Context(), nil) {
+		r.NoError(err, "Alerts returned an error")
+		alerts = append(alerts, alert)
+	}
+	r.Equal(expected.Alerts, alerts, "Expected iterator to match GetAlerts")
+}
+
+func TestClient_AlertRulesIter(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	expected, err := testAPIClient.GetAlertRules()
+	r.NoError(err, "GetAlertRules returned an error")
+
+	var rules []librenms.AlertRule
+	for rule, err := range testAPIClient.AlertRules(t.Context()) {
+		r.NoError(err, "AlertRules returned an error")
+		rules = append(rules, rule)
+	}
+	r.Equal(expected.Rules, rules, "Expected iterator to match GetAlertRules")
+}
+
+func TestClient_LocationsIter(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	expected, err := testAPIClient.GetLocations()
+	r.NoError(err, "GetLocations returned an error")
+
+	var locations []librenms.Location
+	for location, err := range testAPIClient.Locations(t.Context()) {
+		r.NoError(err, "Locations returned an error")
+		locations = append(locations, location)
+	}
+	r.Equal(expected.Locations, locations, "Expected iterator to match GetLocations")
+}
@@ -1,7 +1,9 @@
 package librenms
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -52,7 +54,12 @@ type (
 //
 // Documentation: https://docs.librenms.org/API/Locations/#add_location
 func (c *Client) CreateLocation(location *LocationCreateRequest) (*BaseResponse, error) {
-	req, err := c.newRequest(http.MethodPost, "locations", location, nil)
+	return c.CreateLocationWithContext(context.Background(), location)
+}
+
+// CreateLocationWithContext is like CreateLocation, but uses the provided context for the request.
+func (c *Client) CreateLocationWithContext(ctx context.Context, location *LocationCreateRequest) (*BaseResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodPost, "locations", location, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +72,12 @@ func (c *Client) CreateLocation(location *LocationCreateRequest) (*BaseResponse,
 //
 // Documentation: https://docs.librenms.org/API/Locations/#delete_location
 func (c *Client) DeleteLocation(locationID int) (*BaseResponse, error) {
-	req, err := c.newRequest(http.MethodDelete, fmt.Sprintf("locations/%d", locationID), nil, nil)
+	return c.DeleteLocationWithContext(context.Background(), locationID)
+}
+
+// DeleteLocationWithContext is like DeleteLocation, but uses the provided context for the request.
+func (c *Client) DeleteLocationWithContext(ctx context.Context, locationID int) (*BaseResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodDelete, fmt.Sprintf("locations/%d", locationID), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +90,12 @@ func (c *Client) DeleteLocation(locationID int) (*BaseResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Locations/#get_location
 func (c *Client) GetLocation(locationID int) (*LocationResponse, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf("location/%d", locationID), nil, nil)
+	return c.GetLocationWithContext(context.Background(), locationID)
+}
+
+// GetLocationWithContext is like GetLocation, but uses the provided context for the request.
+func (c *Client) GetLocationWithContext(ctx context.Context, locationID int) (*LocationResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("location/%d", locationID), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +108,12 @@ func (c *Client) GetLocation(locationID int) (*LocationResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Locations/#list_locations
 func (c *Client) GetLocations() (*LocationsResponse, error) {
-	req, err := c.newRequest(http.MethodGet, "resources/locations", nil, nil)
+	return c.GetLocationsWithContext(context.Background())
+}
+
+// GetLocationsWithContext is like GetLocations, but uses the provided context for the request.
+func (c *Client) GetLocationsWithContext(ctx context.Context) (*LocationsResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "resources/locations", nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,11 +122,24 @@ func (c *Client) GetLocations() (*LocationsResponse, error) {
 	return resp, c.do(req, resp)
 }
 
+// Locations returns an iterator over all locations. Unlike GetLocations, the
+// response is decoded one location at a time.
+//
+// Documentation: https://docs.librenms.org/API/Locations/#list_locations
+func (c *Client) Locations(ctx context.Context) iter.Seq2[Location, error] {
+	return listSeq[Location](ctx, c, "resources/locations", nil, listConfig{key: "locations"})
+}
+
 // UpdateLocation updates a location by its ID in the LibreNMS API.
 //
 // Documentation: https://docs.librenms.org/API/Locations/#edit_location
 func (c *Client) UpdateLocation(locationID int, location *LocationUpdateRequest) (*BaseResponse, error) {
-	req, err := c.newRequest(http.MethodPatch, fmt.Sprintf("locations/%d", locationID), location.payload(), nil)
+	return c.UpdateLocationWithContext(context.Background(), locationID, location)
+}
+
+// UpdateLocationWithContext is like UpdateLocation, but uses the provided context for the request.
+func (c *Client) UpdateLocationWithContext(ctx context.Context, locationID int, location *LocationUpdateRequest) (*BaseResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodPatch, fmt.Sprintf("locations/%d", locationID), location.payload(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,395 @@
+package librenms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	logsEndpoint = "logs"
+
+	// LogsSortAscending sorts log entries from oldest to newest.
+	LogsSortAscending = "ASC"
+	// LogsSortDescending sorts log entries from newest to oldest.
+	LogsSortDescending = "DESC"
+
+	// defaultLogsPageSize is the number of log entries requested per page by the log iterators.
+	defaultLogsPageSize = 500
+)
+
+type (
+	// AlertLogEntry represents an entry of the alert log, which records every state
+	// change of an alert.
+	AlertLogEntry struct {
+		ID         int             `json:"id"`
+		DeviceID   int             `json:"device_id"`
+		Details    AlertLogDetails `json:"details"`
+		Hostname   string          `json:"hostname"`
+		RuleID     int             `json:"rule_id"`
+		RuleName   string          `json:"name"`
+		State      int             `json:"state"` // 0 = ok, 1 = alert, 2 = ack, 3 = worse, 4 = better
+		TimeLogged Time            `json:"time_logged"`
+	}
+
+	// AlertLogDetails contains the details of an alert log entry, such as the rule
+	// and the faults that triggered it.
+	//
+	// The API returns the details either as a JSON object or as a JSON-encoded string;
+	// both are parsed into the map.
+	AlertLogDetails map[string]any
+
+	// AlertLogResponse represents a response containing alert log entries.
+	AlertLogResponse struct {
+		BaseResponse
+		Total int             `json:"total"`
+		Logs  []AlertLogEntry `json:"logs"`
+	}
+
+	// AuthLogEntry represents an entry of the authentication log, which records web UI logins.
+	AuthLogEntry struct {
+		ID       int    `json:"id"`
+		Address  string `json:"address"`
+		DateTime Time   `json:"datetime"`
+		Result   string `json:"result"`
+		User     string `json:"user"`
+	}
+
+	// AuthLogResponse represents a response containing authentication log entries.
+	AuthLogResponse struct {
+		BaseResponse
+		Total int            `json:"total"`
+		Logs  []AuthLogEntry `json:"logs"`
+	}
+
+	// EventLogEntry represents an entry of the event log.
+	EventLogEntry struct {
+		ID        int     `json:"event_id"`
+		DeviceID  int     `json:"device_id"`
+		DateTime  Time    `json:"datetime"`
+		Hostname  string  `json:"hostname"`
+		Message   string  `json:"message"`
+		Reference *string `json:"reference"` // e.g. the ID of the port or sensor the event refers to
+		Severity  int     `json:"severity"`  // 1 = ok, 2 = info, 3 = notice, 4 = warning, 5 = error
+		SysName   string  `json:"sysName"`
+		Type      *string `json:"type"` // e.g. "interface", "system", "discovery"
+		Username  *string `json:"username"`
+	}
+
+	// EventLogResponse represents a response containing event log entries.
+	EventLogResponse struct {
+		BaseResponse
+		Total int             `json:"total"`
+		Logs  []EventLogEntry `json:"logs"`
+	}
+
+	// SyslogEntry represents a syslog message received by LibreNMS.
+	SyslogEntry struct {
+		Seq       int64  `json:"seq"`
+		DeviceID  int    `json:"device_id"`
+		Facility  string `json:"facility"`
+		Hostname  string `json:"hostname"`
+		Level     string `json:"level"`
+		Message   string `json:"msg"`
+		Priority  string `json:"priority"`
+		Program   string `json:"program"`
+		SysName   string `json:"sysName"`
+		Tag       string `json:"tag"`
+		Timestamp Time   `json:"timestamp"`
+	}
+
+	// SyslogResponse represents a response containing syslog entries.
+	SyslogResponse struct {
+		BaseResponse
+		Total int           `json:"total"`
+		Logs  []SyslogEntry `json:"logs"`
+	}
+
+	// LogsQuery represents the query parameters for the log endpoints.
+	// The times are sent in the "2006-01-02 15:04:05" layout the API expects.
+	//
+	// Documentation: https://docs.librenms.org/API/Logs/
+	LogsQuery struct {
+		From      *time.Time
+		Limit     *int
+		SortOrder *string // ASC or DESC
+		Start     *int    // offset of the first entry
+		To        *time.Time
+	}
+)
+
+// AlertLogs returns an iterator over the alert log, optionally for a single device by its ID
+// or hostname (pass an empty identifier for all devices). See logsSeq for paging details.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_alertlog
+func (c *Client) AlertLogs(ctx context.Context, identifier string, query *LogsQuery) iter.Seq2[AlertLogEntry, error] {
+	return logsSeq[AlertLogEntry](ctx, c, "alertlog", identifier, query)
+}
+
+// AuthLogs returns an iterator over the authentication log. See logsSeq for paging details.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_authlog
+func (c *Client) AuthLogs(ctx context.Context, query *LogsQuery) iter.Seq2[AuthLogEntry, error] {
+	return logsSeq[AuthLogEntry](ctx, c, "authlog", "", query)
+}
+
+// EventLogs returns an iterator over the event log, optionally for a single device by its ID
+// or hostname (pass an empty identifier for all devices). See logsSeq for paging details.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_eventlog
+func (c *Client) EventLogs(ctx context.Context, identifier string, query *LogsQuery) iter.Seq2[EventLogEntry, error] {
+	return logsSeq[EventLogEntry](ctx, c, "eventlog", identifier, query)
+}
+
+// GetAlertLog retrieves the alert log for all devices.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_alertlog
+func (c *Client) GetAlertLog(query *LogsQuery) (*AlertLogResponse, error) {
+	return c.GetAlertLogWithContext(context.Background(), query)
+}
+
+// GetAlertLogWithContext is like GetAlertLog, but uses the provided context for the request.
+func (c *Client) GetAlertLogWithContext(ctx context.Context, query *LogsQuery) (*AlertLogResponse, error) {
+	resp := new(AlertLogResponse)
+	return resp, c.getLogs(ctx, "alertlog", "", query, resp)
+}
+
+// GetAuthLog retrieves the authentication log.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_authlog
+func (c *Client) GetAuthLog(query *LogsQuery) (*AuthLogResponse, error) {
+	return c.GetAuthLogWithContext(context.Background(), query)
+}
+
+// GetAuthLogWithContext is like GetAuthLog, but uses the provided context for the request.
+func (c *Client) GetAuthLogWithContext(ctx context.Context, query *LogsQuery) (*AuthLogResponse, error) {
+	resp := new(AuthLogResponse)
+	return resp, c.getLogs(ctx, "authlog", "", query, resp)
+}
+
+// GetDeviceAlertLog retrieves the alert log for a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_alertlog
+func (c *Client) GetDeviceAlertLog(identifier string, query *LogsQuery) (*AlertLogResponse, error) {
+	return c.GetDeviceAlertLogWithContext(context.Background(), identifier, query)
+}
+
+// GetDeviceAlertLogWithContext is like GetDeviceAlertLog, but uses the provided context for the request.
+func (c *Client) GetDeviceAlertLogWithContext(ctx context.Context, identifier string, query *LogsQuery) (*AlertLogResponse, error) {
+	if identifier == "" {
+		return nil, fmt.Errorf("device identifier is required")
+	}
+	resp := new(AlertLogResponse)
+	return resp, c.getLogs(ctx, "alertlog", identifier, query, resp)
+}
+
+// GetDeviceEventLog retrieves the event log for a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_eventlog
+func (c *Client) GetDeviceEventLog(identifier string, query *LogsQuery) (*EventLogResponse, error) {
+	return c.GetDeviceEventLogWithContext(context.Background(), identifier, query)
+}
+
+// GetDeviceEventLogWithContext is like GetDeviceEventLog, but uses the provided context for the request.
+func (c *Client) GetDeviceEventLogWithContext(ctx context.Context, identifier string, query *LogsQuery) (*EventLogResponse, error) {
+	if identifier == "" {
+		return nil, fmt.Errorf("device identifier is required")
+	}
+	resp := new(EventLogResponse)
+	return resp, c.getLogs(ctx, "eventlog", identifier, query, resp)
+}
+
+// GetDeviceSyslog retrieves the syslog for a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_syslog
+func (c *Client) GetDeviceSyslog(identifier string, query *LogsQuery) (*SyslogResponse, error) {
+	return c.GetDeviceSyslogWithContext(context.Background(), identifier, query)
+}
+
+// GetDeviceSyslogWithContext is like GetDeviceSyslog, but uses the provided context for the request.
+func (c *Client) GetDeviceSyslogWithContext(ctx context.Context, identifier string, query *LogsQuery) (*SyslogResponse, error) {
+	if identifier == "" {
+		return nil, fmt.Errorf("device identifier is required")
+	}
+	resp := new(SyslogResponse)
+	return resp, c.getLogs(ctx, "syslog", identifier, query, resp)
+}
+
+// GetEventLog retrieves the event log for all devices.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_eventlog
+func (c *Client) GetEventLog(query *LogsQuery) (*EventLogResponse, error) {
+	return c.GetEventLogWithContext(context.Background(), query)
+}
+
+// GetEventLogWithContext is like GetEventLog, but uses the provided context for the request.
+func (c *Client) GetEventLogWithContext(ctx context.Context, query *LogsQuery) (*EventLogResponse, error) {
+	resp := new(EventLogResponse)
+	return resp, c.getLogs(ctx, "eventlog", "", query, resp)
+}
+
+// GetSyslog retrieves the syslog for all devices.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_syslog
+func (c *Client) GetSyslog(query *LogsQuery) (*SyslogResponse, error) {
+	return c.GetSyslogWithContext(context.Background(), query)
+}
+
+// GetSyslogWithContext is like GetSyslog, but uses the provided context for the request.
+func (c *Client) GetSyslogWithContext(ctx context.Context, query *LogsQuery) (*SyslogResponse, error) {
+	resp := new(SyslogResponse)
+	return resp, c.getLogs(ctx, "syslog", "", query, resp)
+}
+
+// Syslogs returns an iterator over the syslog, optionally for a single device by its ID
+// or hostname (pass an empty identifier for all devices). See logsSeq for paging details.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#list_syslog
+func (c *Client) Syslogs(ctx context.Context, identifier string, query *LogsQuery) iter.Seq2[SyslogEntry, error] {
+	return logsSeq[SyslogEntry](ctx, c, "syslog", identifier, query)
+}
+
+// getLogs retrieves the given log type, optionally for a single device, into respObj.
+func (c *Client) getLogs(ctx context.Context, logType, identifier string, query *LogsQuery, respObj any) error {
+	if query == nil {
+		query = NewLogsQuery()
+	}
+
+	uri := fmt.Sprintf("%s/%s", logsEndpoint, logType)
+	if identifier != "" {
+		uri = fmt.Sprintf("%s/%s", uri, identifier)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, query.values())
+	if err != nil {
+		return err
+	}
+	return c.do(req, respObj)
+}
+
+// logsSeq returns an iterator over the entries of the given log type, optionally for a single device.
+//
+// The iterator requests the log one page at a time, walking backwards through time (newest
+// entries first) unless a sort order is set on the query. The query's Limit sets the page size
+// (default 500) and Start sets the offset of the first entry. As paging is offset-based, set
+// the query's To time when walking a log that is still being written to, so new entries don't
+// shift the pages.
+func logsSeq[T any](ctx context.Context, c *Client, logType, identifier string, query *LogsQuery) iter.Seq2[T, error] {
+	if query == nil {
+		query = NewLogsQuery()
+	}
+
+	uri := fmt.Sprintf("%s/%s", logsEndpoint, logType)
+	if identifier != "" {
+		uri = fmt.Sprintf("%s/%s", uri, identifier)
+	}
+
+	params := query.values()
+	params.Del("limit")
+	params.Del("start")
+	if query.SortOrder == nil {
+		params.Set("sortorder", LogsSortDescending)
+	}
+
+	cfg := listConfig{
+		key:         "logs",
+		pageSize:    defaultLogsPageSize,
+		limitParam:  "limit",
+		offsetParam: "start",
+	}
+	if query.Limit != nil && *query.Limit > 0 {
+		cfg.pageSize = *query.Limit
+	}
+	if query.Start != nil {
+		cfg.offset = *query.Start
+	}
+	return listSeq[T](ctx, c, uri, params, cfg)
+}
+
+// UnmarshalJSON implements the JSON unmarshalling for the AlertLogDetails type.
+func (d *AlertLogDetails) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	// the details may be a JSON-encoded string
+	if len(data) > 0 && data[0] == '"' {
+		var valueString string
+		if err := json.Unmarshal(data, &valueString); err != nil {
+			return fmt.Errorf("failed to unmarshal AlertLogDetails: %w", err)
+		}
+		data = []byte(valueString)
+	}
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		*d = nil
+		return nil
+	}
+
+	details := make(map[string]any)
+	if err := json.Unmarshal(data, &details); err != nil {
+		return fmt.Errorf("failed to unmarshal AlertLogDetails: %w", err)
+	}
+	*d = details
+	return nil
+}
+
+// NewLogsQuery creates a new LogsQuery with default values.
+func NewLogsQuery() *LogsQuery {
+	return &LogsQuery{}
+}
+
+// SetFrom sets the earliest time of the log entries to return.
+func (q *LogsQuery) SetFrom(from time.Time) *LogsQuery {
+	q.From = &from
+	return q
+}
+
+// SetLimit sets the maximum number of log entries to return.
+func (q *LogsQuery) SetLimit(limit int) *LogsQuery {
+	q.Limit = &limit
+	return q
+}
+
+// SetSortOrder sets the sort order of the log entries, LogsSortAscending or LogsSortDescending.
+func (q *LogsQuery) SetSortOrder(order string) *LogsQuery {
+	q.SortOrder = &order
+	return q
+}
+
+// SetStart sets the offset of the first log entry to return.
+func (q *LogsQuery) SetStart(start int) *LogsQuery {
+	q.Start = &start
+	return q
+}
+
+// SetTo sets the latest time of the log entries to return.
+func (q *LogsQuery) SetTo(to time.Time) *LogsQuery {
+	q.To = &to
+	return q
+}
+
+// values generates the actual query payload for the request,
+// only including fields that are not nil.
+func (q *LogsQuery) values() *url.Values {
+	v := &url.Values{}
+	if q.From != nil {
+		v.Set("from", q.From.Format(timeLayout))
+	}
+	if q.Limit != nil {
+		v.Set("limit", strconv.Itoa(*q.Limit))
+	}
+	if q.SortOrder != nil {
+		v.Set("sortorder", *q.SortOrder)
+	}
+	if q.Start != nil {
+		v.Set("start", strconv.Itoa(*q.Start))
+	}
+	if q.To != nil {
+		v.Set("to", q.To.Format(timeLayout))
+	}
+	return v
+}
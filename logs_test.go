@@ -0,0 +1,225 @@
+package librenms_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointAlertLog        = "/api/v0/logs/alertlog"
+	testEndpointAuthLog         = "/api/v0/logs/authlog"
+	testEndpointDeviceAlertLog  = "/api/v0/logs/alertlog/1.1.1.1"
+	testEndpointDeviceEventLog  = "/api/v0/logs/eventlog/1.1.1.1"
+	testEndpointDeviceSyslog    = "/api/v0/logs/syslog/1.1.1.1"
+	testEndpointEventLog        = "/api/v0/logs/eventlog"
+	testEndpointEventLogPaged   = "/api/v0/logs/eventlog/paged.example.com"
+	testEventLogPagedTotal      = 7
+	testEventLogPagedPageSize   = 3
+	testEventLogPagedFirstEvent = 100
+)
+
+// This init function will register handlers for log-related API endpoints.
+func init() {
+	handleEndpoint(testEndpointAlertLog, mockResponses{
+		http.MethodGet: loadMockResponse("get_alertlog_200.json"),
+	})
+
+	handleEndpoint(testEndpointAuthLog, mockResponses{
+		http.MethodGet: loadMockResponse("get_authlog_200.json"),
+	})
+
+	handleEndpoint(testEndpointEventLog, mockResponses{
+		http.MethodGet: loadMockResponse("get_eventlog_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceEventLog, mockResponses{
+		http.MethodGet: loadMockResponse("get_eventlog_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceSyslog, mockResponses{
+		http.MethodGet: loadMockResponse("get_syslog_200.json"),
+	})
+
+	// Registering this endpoint outside of handleEndpoint() to serve a paged event log,
+	// with event IDs counting down from testEventLogPagedFirstEvent.
+	mux.HandleFunc(testEndpointEventLogPaged, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		start, _ := strconv.Atoi(q.Get("start"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if q.Get("sortorder") != "DESC" || limit != testEventLogPagedPageSize {
+			http.Error(w, `{"status": "error", "message": "unexpected query"}`, http.StatusBadRequest)
+			return
+		}
+
+		logs := make([]map[string]any, 0)
+		for i := start; i < start+limit && i < testEventLogPagedTotal; i++ {
+			logs = append(logs, map[string]any{
+				"event_id": testEventLogPagedFirstEvent - i,
+				"datetime": "2025-06-01 22:00:00",
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"status": "ok",
+			"count":  len(logs),
+			"total":  testEventLogPagedTotal,
+			"logs":   logs,
+		})
+		handleWriteErr(err, w)
+	})
+
+	// Registering this endpoint outside of handleEndpoint() to verify the query parameters.
+	mux.HandleFunc(testEndpointDeviceAlertLog, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("from") != "2025-06-01 00:00:00" || q.Get("limit") != "50" || q.Get("sortorder") != "DESC" {
+			http.Error(w, `{"status": "error", "message": "unexpected query"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(loadMockResponse("get_alertlog_200.json"))
+		handleWriteErr(err, w)
+	})
+}
+
+func TestClient_GetAlertLog(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetAlertLog(nil)
+
+	r.NoError(err, "GetAlertLog returned an error")
+	r.NotNil(resp, "GetAlertLog response is nil")
+
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Equal(3, resp.Total, "Expected total 3")
+	r.Len(resp.Logs, 3, "Expected 3 log entries")
+
+	entry := resp.Logs[0]
+	r.Equal(101, entry.ID, "Expected ID 101")
+	r.Equal("Devices up/down", entry.RuleName, "Unexpected rule name")
+	r.Equal(time.Date(2025, 6, 1, 22, 0, 0, 0, time.UTC), entry.TimeLogged.Time, "Unexpected time logged")
+
+	// details may be a JSON string, a JSON object or empty
+	r.Contains(entry.Details, "rule", "Expected details to be parsed from a string")
+	r.Contains(resp.Logs[1].Details, "contacts", "Expected details to be parsed from an object")
+	r.Nil(resp.Logs[2].Details, "Expected empty details")
+
+	// time to acknowledge
+	r.Equal(12*time.Minute+30*time.Second, resp.Logs[1].TimeLogged.Sub(entry.TimeLogged.Time), "Unexpected time to ack")
+}
+
+func TestClient_GetDeviceAlertLog(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	query := librenms.NewLogsQuery().
+		SetFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)).
+		SetLimit(50).
+		SetSortOrder(librenms.LogsSortDescending)
+
+	resp, err := testAPIClient.GetDeviceAlertLog("1.1.1.1", query)
+
+	r.NoError(err, "GetDeviceAlertLog returned an error")
+	r.NotNil(resp, "GetDeviceAlertLog response is nil")
+	r.Len(resp.Logs, 3, "Expected 3 log entries")
+
+	_, err = testAPIClient.GetDeviceAlertLog("", nil)
+	r.Error(err, "Expected error for empty identifier")
+}
+
+func TestClient_GetEventLog(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetEventLog(nil)
+
+	r.NoError(err, "GetEventLog returned an error")
+	r.NotNil(resp, "GetEventLog response is nil")
+	r.Len(resp.Logs, 2, "Expected 2 log entries")
+
+	entry := resp.Logs[0]
+	r.Equal(2, entry.ID, "Expected event ID 2")
+	r.Equal(4, entry.Severity, "Expected severity 4")
+	r.Equal("interface", *entry.Type, "Expected type 'interface'")
+	r.Equal("2", *entry.Reference, "Expected reference '2'")
+	r.Equal(time.Date(2025, 6, 1, 22, 5, 0, 0, time.UTC), entry.DateTime.Time, "Unexpected datetime")
+	r.Nil(resp.Logs[1].Reference, "Expected nil reference")
+
+	resp, err = testAPIClient.GetDeviceEventLog("1.1.1.1", nil)
+	r.NoError(err, "GetDeviceEventLog returned an error")
+	r.Len(resp.Logs, 2, "Expected 2 log entries")
+}
+
+func TestClient_GetSyslog(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceSyslog("1.1.1.1", nil)
+
+	r.NoError(err, "GetDeviceSyslog returned an error")
+	r.NotNil(resp, "GetDeviceSyslog response is nil")
+	r.Len(resp.Logs, 1, "Expected 1 log entry")
+
+	entry := resp.Logs[0]
+	r.Equal(int64(1001), entry.Seq, "Expected seq 1001")
+	r.Equal("sshd", entry.Program, "Expected program 'sshd'")
+	r.Equal(time.Date(2025, 6, 1, 22, 1, 2, 0, time.UTC), entry.Timestamp.Time, "Unexpected timestamp")
+}
+
+func TestClient_GetAuthLog(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetAuthLog(nil)
+
+	r.NoError(err, "GetAuthLog returned an error")
+	r.NotNil(resp, "GetAuthLog response is nil")
+	r.Len(resp.Logs, 1, "Expected 1 log entry")
+	r.Equal("admin", resp.Logs[0].User, "Expected user 'admin'")
+	r.Equal("Logged In", resp.Logs[0].Result, "Unexpected result")
+}
+
+func TestClient_EventLogs_Paged(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	query := librenms.NewLogsQuery().SetLimit(testEventLogPagedPageSize)
+
+	var ids []int
+	for entry, err := range testAPIClient.EventLogs(t.Context(), "paged.example.com", query) {
+		r.NoError(err, "EventLogs returned an error")
+		ids = append(ids, entry.ID)
+	}
+
+	r.Len(ids, testEventLogPagedTotal, "Expected all entries across pages")
+	r.Equal(testEventLogPagedFirstEvent, ids[0], "Expected newest entry first")
+	r.Equal(testEventLogPagedFirstEvent-testEventLogPagedTotal+1, ids[len(ids)-1], "Expected oldest entry last")
+}
+
+func TestClient_EventLogs_Start(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	query := librenms.NewLogsQuery().SetLimit(testEventLogPagedPageSize).SetStart(5)
+
+	var ids []int
+	for entry, err := range testAPIClient.EventLogs(t.Context(), "paged.example.com", query) {
+		r.NoError(err, "EventLogs returned an error")
+		ids = append(ids, entry.ID)
+	}
+	r.Equal([]int{testEventLogPagedFirstEvent - 5, testEventLogPagedFirstEvent - 6}, ids, "Expected entries from offset 5")
+}
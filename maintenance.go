@@ -0,0 +1,98 @@
+package librenms
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// maintenanceStartLayout is the time layout expected by the API for the maintenance start.
+	maintenanceStartLayout = "2006-01-02 15:04:00"
+)
+
+// maintenanceDurationPattern matches the "H:i" duration format expected by the API, e.g. "2:00" or "48:30".
+var maintenanceDurationPattern = regexp.MustCompile(`^\d+:[0-5]\d$`)
+
+type (
+	// MaintenanceRequest represents the request payload for putting a device or
+	// device group into maintenance mode.
+	//
+	// Duration is required and uses the format "H:i", e.g. "2:00" for two hours.
+	// Start is optional, uses the format "Y-m-d H:i:00", and defaults to now.
+	// Use SetDuration() and SetStart() to set these fields from Go types.
+	MaintenanceRequest struct {
+		Title    string `json:"title,omitempty"`
+		Notes    string `json:"notes,omitempty"`
+		Start    string `json:"start,omitempty"`
+		Duration string `json:"duration"`
+
+		// durationErr records an invalid duration passed to SetDuration(), which is reported by
+		// Validate() unless Duration has been set since.
+		durationErr error
+	}
+
+	// DeviceMaintenanceResponse represents the maintenance status of a device.
+	DeviceMaintenanceResponse struct {
+		BaseResponse
+		IsUnderMaintenance bool `json:"is_under_maintenance"`
+	}
+)
+
+// NewMaintenanceRequest creates a new MaintenanceRequest with the given title and duration, starting now.
+func NewMaintenanceRequest(title string, duration time.Duration) *MaintenanceRequest {
+	return (&MaintenanceRequest{Title: title}).SetDuration(duration)
+}
+
+// SetDuration sets the duration of the maintenance window, rounded down to the minute.
+// Durations shorter than a minute (including zero and negative durations) are rejected;
+// the request is then left without a duration, and Validate() returns the error until a
+// duration is set.
+func (r *MaintenanceRequest) SetDuration(duration time.Duration) *MaintenanceRequest {
+	if duration < time.Minute {
+		r.Duration = ""
+		r.durationErr = fmt.Errorf("maintenance duration must be at least one minute, got %s", duration)
+		return r
+	}
+	minutes := int(duration.Minutes())
+	r.Duration = fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+	r.durationErr = nil
+	return r
+}
+
+// SetNotes sets the notes of the maintenance window.
+func (r *MaintenanceRequest) SetNotes(notes string) *MaintenanceRequest {
+	r.Notes = notes
+	return r
+}
+
+// SetStart sets the start time of the maintenance window. The time is sent as-is,
+// without a timezone, so it should be in the timezone of the LibreNMS server.
+func (r *MaintenanceRequest) SetStart(start time.Time) *MaintenanceRequest {
+	r.Start = start.Format(maintenanceStartLayout)
+	return r
+}
+
+// Validate checks the request has a valid duration and start time.
+func (r *MaintenanceRequest) Validate() error {
+	if r.Duration == "" {
+		if r.durationErr != nil {
+			return r.durationErr
+		}
+		return errors.New("maintenance duration is required")
+	}
+	if !maintenanceDurationPattern.MatchString(r.Duration) {
+		return fmt.Errorf("invalid maintenance duration %q, expected format 'H:i' (e.g. '2:00')", r.Duration)
+	}
+	if strings.TrimLeft(r.Duration, "0:") == "" {
+		return errors.New("maintenance duration must be at least one minute")
+	}
+	if r.Start != "" {
+		if _, err := time.Parse(maintenanceStartLayout, r.Start); err != nil {
+			return fmt.Errorf("invalid maintenance start %q, expected format 'Y-m-d H:i:00': %w", r.Start, err)
+		}
+	}
+	return nil
+}
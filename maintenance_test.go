@@ -0,0 +1,115 @@
+package librenms_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointDeviceMaintenance      = "/api/v0/devices/1.1.1.1/maintenance"
+	testEndpointDeviceGroupMaintenance = "/api/v0/devicegroups/4/maintenance"
+)
+
+// This init function will register handlers for maintenance-related API endpoints.
+func init() {
+	handleEndpoint(testEndpointDeviceMaintenance, mockResponses{
+		http.MethodGet:  loadMockResponse("get_device_maintenance_200.json"),
+		http.MethodPost: loadMockResponse("maintenance_device_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceGroupMaintenance, mockResponses{
+		http.MethodPost: loadMockResponse("maintenance_devicegroup_200.json"),
+	})
+}
+
+func TestClient_MaintenanceDevice(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	payload := librenms.NewMaintenanceRequest("Firmware upgrade", 2*time.Hour).
+		SetNotes("CHG-1234").
+		SetStart(time.Date(2025, 6, 1, 22, 0, 0, 0, time.UTC))
+
+	resp, err := testAPIClient.MaintenanceDevice("1.1.1.1", payload)
+
+	r.NoError(err, "MaintenanceDevice returned an error")
+	r.NotNil(resp, "MaintenanceDevice response is nil")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+}
+
+func TestClient_MaintenanceDevice_InvalidDuration(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	_, err := testAPIClient.MaintenanceDevice("1.1.1.1", &librenms.MaintenanceRequest{Duration: "2h"})
+	r.Error(err, "Expected error for invalid duration")
+	r.ErrorContains(err, "invalid maintenance duration", "Expected invalid duration error")
+
+	_, err = testAPIClient.MaintenanceDevice("1.1.1.1", nil)
+	r.Error(err, "Expected error for nil payload")
+}
+
+func TestClient_GetDeviceMaintenance(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDeviceMaintenance("1.1.1.1")
+
+	r.NoError(err, "GetDeviceMaintenance returned an error")
+	r.NotNil(resp, "GetDeviceMaintenance response is nil")
+	r.True(resp.IsUnderMaintenance, "Expected device to be under maintenance")
+}
+
+func TestClient_MaintenanceDeviceGroup(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.MaintenanceDeviceGroup("4", librenms.NewMaintenanceRequest("Patching", 90*time.Minute))
+
+	r.NoError(err, "MaintenanceDeviceGroup returned an error")
+	r.NotNil(resp, "MaintenanceDeviceGroup response is nil")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+}
+
+func TestMaintenanceRequest_Validate(t *testing.T) {
+	r := require.New(t)
+
+	req := librenms.NewMaintenanceRequest("test", 90*time.Minute)
+	r.Equal("1:30", req.Duration, "Unexpected duration format")
+	r.NoError(req.Validate(), "Expected valid request")
+
+	req.SetStart(time.Date(2025, 6, 1, 22, 15, 0, 0, time.UTC))
+	r.Equal("2025-06-01 22:15:00", req.Start, "Unexpected start format")
+	r.NoError(req.Validate(), "Expected valid request")
+
+	req.Start = "tomorrow"
+	r.Error(req.Validate(), "Expected error for invalid start")
+
+	r.Error((&librenms.MaintenanceRequest{}).Validate(), "Expected error for missing duration")
+	r.Error((&librenms.MaintenanceRequest{Duration: "1:75"}).Validate(), "Expected error for invalid minutes")
+	r.Error((&librenms.MaintenanceRequest{Duration: "0:00"}).Validate(), "Expected error for zero duration")
+
+	for _, duration := range []time.Duration{0, -90 * time.Minute, 30 * time.Second} {
+		req = librenms.NewMaintenanceRequest("test", duration)
+		r.Empty(req.Duration, "Expected no duration for %s", duration)
+		r.ErrorContains(req.Validate(), "at least one minute", "Expected error for %s", duration)
+	}
+
+	// a valid duration replaces an invalid one
+	req.SetDuration(time.Minute)
+	r.Equal("0:01", req.Duration, "Unexpected duration format")
+	r.NoError(req.Validate(), "Expected valid request")
+
+	// setting the field directly also replaces an invalid duration
+	req = librenms.NewMaintenanceRequest("test", 0)
+	req.Duration = "1:00"
+	r.NoError(req.Validate(), "Expected valid request after setting the duration field")
+}
@@ -0,0 +1,182 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+const (
+	// pollerGroupEndpoint is the API endpoint for poller groups.
+	pollerGroupEndpoint = "poller_group"
+)
+
+type (
+	// PollerGroup represents a poller group in LibreNMS. Devices are assigned to a poller group,
+	// and are polled by the distributed pollers of that group. The default group has the ID 0,
+	// and is not returned by the API.
+	PollerGroup struct {
+		ID          int    `json:"id"`
+		Name        string `json:"group_name"`
+		Description string `json:"descr"`
+	}
+
+	// PollerGroupResponse represents a response containing a list of poller groups from the LibreNMS API.
+	PollerGroupResponse struct {
+		BaseResponse
+		Groups []PollerGroup `json:"get_poller_group"`
+	}
+
+	// PollerGroupStatus summarizes the devices and polling times of a poller group.
+	// Times are in seconds, based on the last poll of each device; disabled devices aren't polled,
+	// so they're only included in the Disabled count.
+	PollerGroupStatus struct {
+		GroupID int
+		Name    string // empty for the default group, or if the group no longer exists
+
+		Devices  int
+		Disabled int
+		Down     int
+		Up       int
+
+		AveragePollTime float64
+		MaxPollTime     float64
+		MaxPollDeviceID int
+	}
+)
+
+// GetPollerGroup retrieves a poller group by its ID or name.
+//
+// Documentation: https://docs.librenms.org/API/PollerGroups/#get_poller_group
+func (c *Client) GetPollerGroup(identifier string) (*PollerGroupResponse, error) {
+	return c.GetPollerGroupWithContext(context.Background(), identifier)
+}
+
+// GetPollerGroupWithContext is like GetPollerGroup, but uses the provided context for the request.
+func (c *Client) GetPollerGroupWithContext(ctx context.Context, identifier string) (*PollerGroupResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("poller group identifier is required")
+	}
+	return c.getPollerGroups(ctx, fmt.Sprintf("%s/%s", pollerGroupEndpoint, url.PathEscape(identifier)))
+}
+
+// GetPollerGroups retrieves a list of poller groups from the LibreNMS API.
+//
+// Documentation: https://docs.librenms.org/API/PollerGroups/#get_poller_group
+func (c *Client) GetPollerGroups() (*PollerGroupResponse, error) {
+	return c.GetPollerGroupsWithContext(context.Background())
+}
+
+// GetPollerGroupsWithContext is like GetPollerGroups, but uses the provided context for the request.
+func (c *Client) GetPollerGroupsWithContext(ctx context.Context) (*PollerGroupResponse, error) {
+	return c.getPollerGroups(ctx, pollerGroupEndpoint)
+}
+
+// GetPollerGroupStatus summarizes the devices of each poller group, sorted by group ID.
+//
+// The API doesn't expose the distributed pollers themselves, so the status is built from
+// the device list; only groups with at least one device are included.
+func (c *Client) GetPollerGroupStatus() ([]PollerGroupStatus, error) {
+	return c.GetPollerGroupStatusWithContext(context.Background())
+}
+
+// GetPollerGroupStatusWithContext is like GetPollerGroupStatus, but uses the provided context for the request.
+func (c *Client) GetPollerGroupStatusWithContext(ctx context.Context) ([]PollerGroupStatus, error) {
+	groups, err := c.GetPollerGroupsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(groups.Groups))
+	for _, group := range groups.Groups {
+		names[group.ID] = group.Name
+	}
+
+	statuses := make(map[int]*PollerGroupStatus)
+	for device, err := range c.Devices(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+
+		status, ok := statuses[device.PollerGroup]
+		if !ok {
+			status = &PollerGroupStatus{GroupID: device.PollerGroup, Name: names[device.PollerGroup]}
+			statuses[device.PollerGroup] = status
+		}
+		status.Devices++
+
+		if device.Disabled {
+			status.Disabled++
+			continue
+		}
+		if device.Status {
+			status.Up++
+		} else {
+			status.Down++
+		}
+		status.AveragePollTime += device.LastPolledTimeTaken
+		if device.LastPolledTimeTaken > status.MaxPollTime {
+			status.MaxPollTime = device.LastPolledTimeTaken
+			status.MaxPollDeviceID = device.DeviceID
+		}
+	}
+
+	result := make([]PollerGroupStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if polled := status.Devices - status.Disabled; polled > 0 {
+			status.AveragePollTime /= float64(polled)
+		}
+		result = append(result, *status)
+	}
+	slices.SortFunc(result, func(a, b PollerGroupStatus) int {
+		return a.GroupID - b.GroupID
+	})
+	return result, nil
+}
+
+// ResolvePollerGroup returns the ID of a poller group by its ID or name. An error
+// wrapping ErrNotFound is returned if no such group exists.
+func (c *Client) ResolvePollerGroup(identifier string) (int, error) {
+	return c.ResolvePollerGroupWithContext(context.Background(), identifier)
+}
+
+// ResolvePollerGroupWithContext is like ResolvePollerGroup, but uses the provided context for the request.
+func (c *Client) ResolvePollerGroupWithContext(ctx context.Context, identifier string) (int, error) {
+	// the default group isn't returned by the API
+	if identifier == "0" {
+		return 0, nil
+	}
+
+	resp, err := c.GetPollerGroupWithContext(ctx, identifier)
+	if err != nil && !IsNotFound(err) {
+		return 0, err
+	}
+	if resp != nil {
+		// prefer an exact name match, as a numeric name may also match another group's ID
+		for _, group := range resp.Groups {
+			if group.Name == identifier {
+				return group.ID, nil
+			}
+		}
+		for _, group := range resp.Groups {
+			if strconv.Itoa(group.ID) == identifier {
+				return group.ID, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w: poller group %q", ErrNotFound, identifier)
+}
+
+// getPollerGroups retrieves the poller groups from the given URI.
+func (c *Client) getPollerGroups(ctx context.Context, uri string) (*PollerGroupResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PollerGroupResponse)
+	return resp, c.do(req, resp)
+}
@@ -0,0 +1,121 @@
+package librenms_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointPollerGroup        = "/api/v0/poller_group/edge"
+	testEndpointPollerGroupByID    = "/api/v0/poller_group/1"
+	testEndpointPollerGroupMissing = "/api/v0/poller_group/missing"
+	testEndpointPollerGroups       = "/api/v0/poller_group"
+)
+
+// This init function will register handlers for poller group API endpoints.
+func init() {
+	handleEndpoint(testEndpointPollerGroup, mockResponses{
+		http.MethodGet: loadMockResponse("get_poller_group_200.json"),
+	})
+
+	handleEndpoint(testEndpointPollerGroupByID, mockResponses{
+		http.MethodGet: loadMockResponse("get_poller_group_200.json"),
+	})
+
+	// Registering this endpoint outside of handleEndpoint() to mock the HTTP 404 response.
+	mux.HandleFunc(testEndpointPollerGroupMissing, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status": "error", "message": "No poller group found"}`, http.StatusNotFound)
+	})
+
+	handleEndpoint(testEndpointPollerGroups, mockResponses{
+		http.MethodGet: loadMockResponse("get_poller_groups_200.json"),
+	})
+}
+
+func TestClient_GetPollerGroups(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetPollerGroups()
+
+	r.NoError(err, "GetPollerGroups returned an error")
+	r.NotNil(resp, "GetPollerGroups response is nil")
+	r.Equal(2, resp.Count, "Expected count 2")
+	r.Len(resp.Groups, 2, "Expected 2 poller groups")
+	r.Equal("core", resp.Groups[1].Name, "Unexpected group name")
+	r.Equal("Core pollers", resp.Groups[1].Description, "Unexpected group description")
+
+	resp, err = testAPIClient.GetPollerGroup("edge")
+	r.NoError(err, "GetPollerGroup returned an error")
+	r.Len(resp.Groups, 1, "Expected 1 poller group")
+	r.Equal(1, resp.Groups[0].ID, "Expected GroupID 1")
+
+	_, err = testAPIClient.GetPollerGroup("missing")
+	r.True(librenms.IsNotFound(err), "Expected not found error for a missing group")
+}
+
+func TestClient_ResolvePollerGroup(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	for _, identifier := range []string{"edge", "1"} {
+		id, err := testAPIClient.ResolvePollerGroup(identifier)
+		r.NoError(err, "ResolvePollerGroup returned an error for %q", identifier)
+		r.Equal(1, id, "Unexpected poller group ID for %q", identifier)
+	}
+
+	id, err := testAPIClient.ResolvePollerGroup("0")
+	r.NoError(err, "ResolvePollerGroup returned an error for the default group")
+	r.Zero(id, "Expected the default poller group")
+
+	_, err = testAPIClient.ResolvePollerGroup("missing")
+	r.True(librenms.IsNotFound(err), "Expected not found error for a missing group")
+}
+
+func TestClient_GetPollerGroupStatus(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	statuses, err := testAPIClient.GetPollerGroupStatus()
+
+	r.NoError(err, "GetPollerGroupStatus returned an error")
+	r.Len(statuses, 1, "Expected only the default poller group")
+
+	status := statuses[0]
+	r.Zero(status.GroupID, "Expected the default poller group")
+	r.Empty(status.Name, "Expected no name for the default poller group")
+	r.Equal(3, status.Devices, "Expected 3 devices")
+	r.Equal(3, status.Up, "Expected 3 devices up")
+	r.Zero(status.Down, "Expected no devices down")
+	r.Equal(2, status.MaxPollDeviceID, "Expected device 2 to be the slowest")
+	r.InDelta(39.986, status.MaxPollTime, 0.001, "Unexpected max poll time")
+	r.InDelta(16.947, status.AveragePollTime, 0.001, "Unexpected average poll time")
+}
+
+func TestClient_CreateDevice_PollerGroupName(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	payload := &librenms.DeviceCreateRequest{
+		Hostname:        "192.168.10.5",
+		PollerGroupName: "edge",
+	}
+	resp, err := testAPIClient.CreateDevice(payload)
+
+	r.NoError(err, "CreateDevice returned an error")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Zero(payload.PollerGroup, "Expected the payload not to be modified")
+
+	_, err = testAPIClient.CreateDevice(&librenms.DeviceCreateRequest{Hostname: "192.168.10.5", PollerGroupName: "missing"})
+	r.True(librenms.IsNotFound(err), "Expected not found error for a missing group")
+
+	_, err = testAPIClient.CreateDevice(&librenms.DeviceCreateRequest{Hostname: "192.168.10.5", PollerGroup: 2, PollerGroupName: "edge"})
+	r.Error(err, "Expected error when both poller group and name are set")
+}
@@ -0,0 +1,298 @@
+package librenms
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// portEndpoint is the API endpoint for ports.
+	portEndpoint = "ports"
+)
+
+type (
+	// Port represents a network interface in LibreNMS.
+	//
+	// The API only returns the columns requested with PortsQuery.Columns (the default
+	// varies by endpoint), so any field may be left at its zero value.
+	// Pointers are used for fields that may be null.
+	// A custom type Bool is used to represent booleans that may be defined as 0/1 by the API,
+	// and Float64 is used for counters and rates, which may be returned as strings.
+	Port struct {
+		PortID   int `json:"port_id"`
+		DeviceID int `json:"device_id"`
+
+		Deleted            Bool     `json:"deleted"`
+		Detailed           Bool     `json:"detailed"`
+		Disabled           Bool     `json:"disabled"`
+		Ignore             Bool     `json:"ignore"`
+		IfAdminStatus      *string  `json:"ifAdminStatus"` // up, down, testing
+		IfAlias            *string  `json:"ifAlias"`
+		IfConnectorPresent *string  `json:"ifConnectorPresent"`
+		IfDescr            *string  `json:"ifDescr"`
+		IfDuplex           *string  `json:"ifDuplex"`
+		IfHighSpeed        *Float64 `json:"ifHighSpeed"`
+		IfIndex            *int     `json:"ifIndex"`
+		IfLastChange       *Float64 `json:"ifLastChange"`
+		IfMtu              *int     `json:"ifMtu"`
+		IfName             *string  `json:"ifName"`
+		IfOperStatus       *string  `json:"ifOperStatus"` // up, down, testing, unknown, dormant, notPresent, lowerLayerDown
+		IfOperStatusPrev   *string  `json:"ifOperStatus_prev"`
+		IfPhysAddress      *string  `json:"ifPhysAddress"`
+		IfPromiscuousMode  *string  `json:"ifPromiscuousMode"`
+		IfSpeed            *Float64 `json:"ifSpeed"`
+		IfTrunk            *string  `json:"ifTrunk"`
+		IfType             *string  `json:"ifType"`
+		IfVlan             *string  `json:"ifVlan"`
+		IfVrf              *int     `json:"ifVrf"`
+		PortDescrCircuit   *string  `json:"port_descr_circuit"`
+		PortDescrDescr     *string  `json:"port_descr_descr"`
+		PortDescrNotes     *string  `json:"port_descr_notes"`
+		PortDescrSpeed     *string  `json:"port_descr_speed"`
+		PortDescrType      *string  `json:"port_descr_type"`
+		PortName           *string  `json:"portName"`
+		PollPeriod         *Float64 `json:"poll_period"`
+		PollPrev           *Float64 `json:"poll_prev"`
+		PollTime           *Float64 `json:"poll_time"`
+
+		// Counters and rates
+		IfInBroadcastPkts      *Float64 `json:"ifInBroadcastPkts"`
+		IfInBroadcastPktsRate  *Float64 `json:"ifInBroadcastPkts_rate"`
+		IfInDiscards           *Float64 `json:"ifInDiscards"`
+		IfInDiscardsRate       *Float64 `json:"ifInDiscards_rate"`
+		IfInErrors             *Float64 `json:"ifInErrors"`
+		IfInErrorsDelta        *Float64 `json:"ifInErrors_delta"`
+		IfInErrorsRate         *Float64 `json:"ifInErrors_rate"`
+		IfInMulticastPkts      *Float64 `json:"ifInMulticastPkts"`
+		IfInMulticastPktsRate  *Float64 `json:"ifInMulticastPkts_rate"`
+		IfInOctets             *Float64 `json:"ifInOctets"`
+		IfInOctetsDelta        *Float64 `json:"ifInOctets_delta"`
+		IfInOctetsRate         *Float64 `json:"ifInOctets_rate"`
+		IfInUcastPkts          *Float64 `json:"ifInUcastPkts"`
+		IfInUcastPktsDelta     *Float64 `json:"ifInUcastPkts_delta"`
+		IfInUcastPktsRate      *Float64 `json:"ifInUcastPkts_rate"`
+		IfOutBroadcastPkts     *Float64 `json:"ifOutBroadcastPkts"`
+		IfOutBroadcastPktsRate *Float64 `json:"ifOutBroadcastPkts_rate"`
+		IfOutDiscards          *Float64 `json:"ifOutDiscards"`
+		IfOutDiscardsRate      *Float64 `json:"ifOutDiscards_rate"`
+		IfOutErrors            *Float64 `json:"ifOutErrors"`
+		IfOutErrorsDelta       *Float64 `json:"ifOutErrors_delta"`
+		IfOutErrorsRate        *Float64 `json:"ifOutErrors_rate"`
+		IfOutMulticastPkts     *Float64 `json:"ifOutMulticastPkts"`
+		IfOutMulticastPktsRate *Float64 `json:"ifOutMulticastPkts_rate"`
+		IfOutOctets            *Float64 `json:"ifOutOctets"`
+		IfOutOctetsDelta       *Float64 `json:"ifOutOctets_delta"`
+		IfOutOctetsRate        *Float64 `json:"ifOutOctets_rate"`
+		IfOutUcastPkts         *Float64 `json:"ifOutUcastPkts"`
+		IfOutUcastPktsDelta    *Float64 `json:"ifOutUcastPkts_delta"`
+		IfOutUcastPktsRate     *Float64 `json:"ifOutUcastPkts_rate"`
+	}
+
+	// PortIPAddress represents an IPv4 or IPv6 address assigned to a port.
+	//
+	// Only the fields for the matching address family are set.
+	PortIPAddress struct {
+		PortID      int    `json:"port_id"`
+		ContextName string `json:"context_name"`
+
+		IPv4AddressID *int    `json:"ipv4_address_id"`
+		IPv4Address   *string `json:"ipv4_address"`
+		IPv4PrefixLen *int    `json:"ipv4_prefixlen"`
+		IPv4NetworkID *int    `json:"ipv4_network_id"`
+
+		IPv6AddressID  *int    `json:"ipv6_address_id"`
+		IPv6Address    *string `json:"ipv6_address"`
+		IPv6Compressed *string `json:"ipv6_compressed"`
+		IPv6PrefixLen  *int    `json:"ipv6_prefixlen"`
+		IPv6Origin     *string `json:"ipv6_origin"`
+		IPv6NetworkID  *int    `json:"ipv6_network_id"`
+	}
+
+	// PortsQuery represents the query parameters for port listings.
+	//
+	// Columns selects which port columns the API returns, e.g. []string{"port_id", "ifName", "ifOperStatus"}.
+	// If empty, the API default for the endpoint is used.
+	PortsQuery struct {
+		Columns []string `url:"columns,omitempty" del:","`
+	}
+
+	// PortResponse represents a response containing a list of ports from the LibreNMS API.
+	PortResponse struct {
+		BaseResponse
+		Ports []Port `json:"ports"`
+	}
+
+	// portInfoResponse is the internal response structure for a single port, which
+	// uses the key "port" rather than "ports". It's normalized into a PortResponse.
+	portInfoResponse struct {
+		BaseResponse
+		Port []Port `json:"port"`
+	}
+
+	// PortIPResponse represents a response containing the IP addresses of a port.
+	PortIPResponse struct {
+		BaseResponse
+		Addresses []PortIPAddress `json:"addresses"`
+	}
+
+	// portDescriptionRequest is the request payload for updating a port description.
+	portDescriptionRequest struct {
+		Description string `json:"description"`
+	}
+)
+
+// GetPort retrieves all information about a port by its ID.
+//
+// Documentation: https://docs.librenms.org/API/Ports/#get_port_info
+func (c *Client) GetPort(portID int) (*PortResponse, error) {
+	return c.GetPortWithContext(context.Background(), portID)
+}
+
+// GetPortWithContext is like GetPort, but uses the provided context for the request.
+func (c *Client) GetPortWithContext(ctx context.Context, portID int) (*PortResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d", portEndpoint, portID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	internalResp := new(portInfoResponse)
+	if err = c.do(req, internalResp); err != nil {
+		return nil, err
+	}
+
+	return &PortResponse{
+		BaseResponse: BaseResponse{
+			Status:  internalResp.Status,
+			Message: internalResp.Message,
+			Count:   len(internalResp.Port),
+		},
+		Ports: internalResp.Port,
+	}, nil
+}
+
+// GetPortIPAddresses retrieves the IP addresses assigned to a port by its ID.
+//
+// Documentation: https://docs.librenms.org/API/Ports/#get_port_ip_info
+func (c *Client) GetPortIPAddresses(portID int) (*PortIPResponse, error) {
+	return c.GetPortIPAddressesWithContext(context.Background(), portID)
+}
+
+// GetPortIPAddressesWithContext is like GetPortIPAddresses, but uses the provided context for the request.
+func (c *Client) GetPortIPAddressesWithContext(ctx context.Context, portID int) (*PortIPResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d/ip", portEndpoint, portID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortIPResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetPorts retrieves all ports from the LibreNMS API.
+//
+// Documentation: https://docs.librenms.org/API/Ports/#get_all_ports
+func (c *Client) GetPorts(query *PortsQuery) (*PortResponse, error) {
+	return c.GetPortsWithContext(context.Background(), query)
+}
+
+// GetPortsWithContext is like GetPorts, but uses the provided context for the request.
+func (c *Client) GetPortsWithContext(ctx context.Context, query *PortsQuery) (*PortResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, portEndpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortResponse)
+	return resp, c.do(req, resp)
+}
+
+// Ports returns an iterator over all ports. Unlike GetPorts, the response is
+// decoded one port at a time, which keeps memory usage low on large inventories.
+//
+// Documentation: https://docs.librenms.org/API/Ports/#get_all_ports
+func (c *Client) Ports(ctx context.Context, query *PortsQuery) iter.Seq2[Port, error] {
+	params, err := parseParams(query)
+	if err != nil {
+		return errSeq[Port](err)
+	}
+	return listSeq[Port](ctx, c, portEndpoint, params, listConfig{key: "ports"})
+}
+
+// SearchPorts searches the ifAlias, ifDescr and ifName of all ports for the given string.
+//
+// Documentation: https://docs.librenms.org/API/Ports/#search_ports
+func (c *Client) SearchPorts(search string, query *PortsQuery) (*PortResponse, error) {
+	return c.SearchPortsWithContext(context.Background(), search, query)
+}
+
+// SearchPortsWithContext is like SearchPorts, but uses the provided context for the request.
+func (c *Client) SearchPortsWithContext(ctx context.Context, search string, query *PortsQuery) (*PortResponse, error) {
+	return c.searchPorts(ctx, fmt.Sprintf("%s/search/%s", portEndpoint, url.PathEscape(search)), query)
+}
+
+// SearchPortsByField searches the given port fields (e.g. "ifAlias", "ifName", "port_descr_circuit")
+// for the given string.
+//
+// Documentation: https://docs.librenms.org/API/Ports/#search_ports
+func (c *Client) SearchPortsByField(fields []string, search string, query *PortsQuery) (*PortResponse, error) {
+	return c.SearchPortsByFieldWithContext(context.Background(), fields, search, query)
+}
+
+// SearchPortsByFieldWithContext is like SearchPortsByField, but uses the provided context for the request.
+func (c *Client) SearchPortsByFieldWithContext(ctx context.Context, fields []string, search string, query *PortsQuery) (*PortResponse, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("at least one search field is required")
+	}
+	uri := fmt.Sprintf("%s/search/%s/%s", portEndpoint, url.PathEscape(strings.Join(fields, ",")), url.PathEscape(search))
+	return c.searchPorts(ctx, uri, query)
+}
+
+// UpdatePortDescription updates the description (ifAlias) of a port by its ID.
+// An empty description resets the port to the description reported by the device.
+//
+// Documentation: https://docs.librenms.org/API/Ports/#update_port_description
+func (c *Client) UpdatePortDescription(portID int, description string) (*BaseResponse, error) {
+	return c.UpdatePortDescriptionWithContext(context.Background(), portID, description)
+}
+
+// UpdatePortDescriptionWithContext is like UpdatePortDescription, but uses the provided context for the request.
+func (c *Client) UpdatePortDescriptionWithContext(ctx context.Context, portID int, description string) (*BaseResponse, error) {
+	payload := &portDescriptionRequest{Description: description}
+	req, err := c.newRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%d/description", portEndpoint, portID), payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
+
+// searchPorts performs a port search request against the given URI.
+func (c *Client) searchPorts(ctx context.Context, uri string, query *PortsQuery) (*PortResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortResponse)
+	return resp, c.do(req, resp)
+}
+
+// NewPortsQuery creates a new PortsQuery with the given columns.
+func NewPortsQuery(columns ...string) *PortsQuery {
+	return &PortsQuery{Columns: columns}
+}
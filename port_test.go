@@ -0,0 +1,184 @@
+package librenms_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPortID                   = 2
+	testEndpointPorts            = "/api/v0/ports"
+	testEndpointPort             = "/api/v0/ports/2"
+	testEndpointPortIP           = "/api/v0/ports/2/ip"
+	testEndpointPortDescription  = "/api/v0/ports/2/description"
+	testEndpointPortSearch       = "/api/v0/ports/search/uplink"
+	testEndpointPortSearchFields = "/api/v0/ports/search/ifAlias,ifName/uplink"
+	testEndpointDevicePorts      = "/api/v0/devices/1.1.1.1/ports"
+)
+
+// This init function will register handlers for port-related API endpoints.
+func init() {
+	handleEndpoint(testEndpointPorts, mockResponses{
+		http.MethodGet: loadMockResponse("get_ports_200.json"),
+	})
+
+	handleEndpoint(testEndpointPort, mockResponses{
+		http.MethodGet: loadMockResponse("get_port_200.json"),
+	})
+
+	handleEndpoint(testEndpointPortIP, mockResponses{
+		http.MethodGet: loadMockResponse("get_port_ip_200.json"),
+	})
+
+	handleEndpoint(testEndpointPortDescription, mockResponses{
+		http.MethodPatch: loadMockResponse("update_port_description_200.json"),
+	})
+
+	handleEndpoint(testEndpointPortSearchFields, mockResponses{
+		http.MethodGet: loadMockResponse("search_ports_200.json"),
+	})
+
+	handleEndpoint(testEndpointDevicePorts, mockResponses{
+		http.MethodGet: loadMockResponse("get_device_ports_200.json"),
+	})
+
+	// Registering this endpoint outside of handleEndpoint() to verify the columns query parameter.
+	mux.HandleFunc(testEndpointPortSearch, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("columns") != "port_id,ifName,ifAlias" {
+			http.Error(w, `{"status": "error", "message": "unexpected columns"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(loadMockResponse("search_ports_200.json"))
+		handleWriteErr(err, w)
+	})
+}
+
+func TestClient_GetPort(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	portResp, err := testAPIClient.GetPort(testPortID)
+
+	r.NoError(err, "GetPort returned an error")
+	r.NotNil(portResp, "GetPort response is nil")
+
+	r.Equal("ok", portResp.Status, "Expected status 'ok'")
+	r.Equal(1, portResp.Count, "Expected count 1")
+	r.Len(portResp.Ports, 1, "Expected 1 port")
+
+	port := portResp.Ports[0]
+	r.Equal(testPortID, port.PortID, "Expected PortID 2")
+	r.Equal("eth0", *port.IfName, "Expected ifName 'eth0'")
+	r.Equal("up", *port.IfOperStatus, "Expected ifOperStatus 'up'")
+	r.Equal(librenms.Float64(1000000000), *port.IfSpeed, "Expected ifSpeed 1000000000")
+	r.Equal(librenms.Float64(29.447), *port.IfInOctetsRate, "Expected ifInOctets_rate 29.447")
+	r.Equal(librenms.Bool(false), port.Disabled, "Expected disabled false (0)")
+}
+
+func TestClient_GetPorts(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	portResp, err := testAPIClient.GetPorts(librenms.NewPortsQuery("port_id", "ifName"))
+
+	r.NoError(err, "GetPorts returned an error")
+	r.NotNil(portResp, "GetPorts response is nil")
+
+	r.Equal("ok", portResp.Status, "Expected status 'ok'")
+	r.Equal(3, portResp.Count, "Expected count 3")
+	r.Len(portResp.Ports, 3, "Expected 3 ports")
+
+	// verify Float64 fields unmarshal from strings, numbers and nulls
+	r.Equal(librenms.Float64(10000000), *portResp.Ports[0].IfSpeed, "Expected ifSpeed from string")
+	r.Equal(librenms.Float64(2048), *portResp.Ports[1].IfInOctetsRate, "Expected ifInOctets_rate from string")
+	r.Nil(portResp.Ports[2].IfSpeed, "Expected nil ifSpeed")
+}
+
+func TestClient_Ports(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	var ports []librenms.Port
+	for port, err := range testAPIClient.Ports(t.Context(), nil) {
+		r.NoError(err, "Ports returned an error")
+		ports = append(ports, port)
+	}
+	r.Len(ports, 3, "Expected 3 ports")
+}
+
+func TestClient_GetPortIPAddresses(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetPortIPAddresses(testPortID)
+
+	r.NoError(err, "GetPortIPAddresses returned an error")
+	r.NotNil(resp, "GetPortIPAddresses response is nil")
+
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Len(resp.Addresses, 2, "Expected 2 addresses")
+	r.Equal("192.168.1.10", *resp.Addresses[0].IPv4Address, "Unexpected IPv4 address")
+	r.Nil(resp.Addresses[0].IPv6Address, "Expected nil IPv6 address")
+	r.Equal("fe80::5054:ff:fe12:3456", *resp.Addresses[1].IPv6Compressed, "Unexpected IPv6 address")
+}
+
+func TestClient_SearchPorts(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.SearchPorts("uplink", librenms.NewPortsQuery("port_id", "ifName", "ifAlias"))
+
+	r.NoError(err, "SearchPorts returned an error")
+	r.NotNil(resp, "SearchPorts response is nil")
+
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Len(resp.Ports, 1, "Expected 1 port")
+	r.Equal("uplink", *resp.Ports[0].IfAlias, "Expected ifAlias 'uplink'")
+}
+
+func TestClient_SearchPortsByField(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.SearchPortsByField([]string{"ifAlias", "ifName"}, "uplink", nil)
+
+	r.NoError(err, "SearchPortsByField returned an error")
+	r.Len(resp.Ports, 1, "Expected 1 port")
+
+	_, err = testAPIClient.SearchPortsByField(nil, "uplink", nil)
+	r.Error(err, "Expected error when no search fields are given")
+}
+
+func TestClient_GetDevicePorts(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetDevicePorts("1.1.1.1", nil)
+
+	r.NoError(err, "GetDevicePorts returned an error")
+	r.Equal(2, resp.Count, "Expected count 2")
+	r.Equal("eth0", *resp.Ports[1].IfName, "Expected ifName 'eth0'")
+}
+
+func TestClient_UpdatePortDescription(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.UpdatePortDescription(testPortID, "new uplink")
+
+	r.NoError(err, "UpdatePortDescription returned an error")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Equal("Port description updated.", resp.Message, "Unexpected message")
+}
@@ -0,0 +1,188 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// portGroupEndpoint is the API endpoint for port groups.
+	portGroupEndpoint = "port_groups"
+)
+
+type (
+	// PortGroup represents a port group in LibreNMS.
+	PortGroup struct {
+		ID          int     `json:"id"`
+		Name        string  `json:"name"`
+		Description *string `json:"desc"`
+	}
+
+	// PortGroupCreateRequest represents the request payload for creating a port group.
+	PortGroupCreateRequest struct {
+		Name        string  `json:"name"`
+		Description *string `json:"desc,omitempty"`
+	}
+
+	// PortGroupCreateResponse represents a creation response.
+	PortGroupCreateResponse struct {
+		BaseResponse
+		ID int `json:"id"`
+	}
+
+	// PortGroupResponse represents a response containing a list of port groups from the LibreNMS API.
+	PortGroupResponse struct {
+		BaseResponse
+		Groups []PortGroup `json:"groups"`
+	}
+
+	// portGroupPortsRequest is the request payload for assigning ports to or removing ports from a port group.
+	portGroupPortsRequest struct {
+		PortIDs []int `json:"port_ids"`
+	}
+)
+
+// AssignPortGroup adds ports to a port group by their port IDs.
+//
+// Documentation: https://docs.librenms.org/API/PortGroups/#assign_port_group
+func (c *Client) AssignPortGroup(groupID int, portIDs []int) (*BaseResponse, error) {
+	return c.AssignPortGroupWithContext(context.Background(), groupID, portIDs)
+}
+
+// AssignPortGroupWithContext is like AssignPortGroup, but uses the provided context for the request.
+func (c *Client) AssignPortGroupWithContext(ctx context.Context, groupID int, portIDs []int) (*BaseResponse, error) {
+	return c.updatePortGroupPorts(ctx, groupID, "assign", portIDs)
+}
+
+// CreatePortGroup creates a port group in the LibreNMS API.
+//
+// Documentation: https://docs.librenms.org/API/PortGroups/#add_port_group
+func (c *Client) CreatePortGroup(group *PortGroupCreateRequest) (*PortGroupCreateResponse, error) {
+	return c.CreatePortGroupWithContext(context.Background(), group)
+}
+
+// CreatePortGroupWithContext is like CreatePortGroup, but uses the provided context for the request.
+func (c *Client) CreatePortGroupWithContext(ctx context.Context, group *PortGroupCreateRequest) (*PortGroupCreateResponse, error) {
+	if group == nil || group.Name == "" {
+		return nil, errors.New("port group name is required")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, portGroupEndpoint, group, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortGroupCreateResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetPortGroup uses the same endpoint as GetPortGroups, but it returns a
+// modified payload with the single group (if a match is found).
+// The identifier can be either the group ID or the group name.
+func (c *Client) GetPortGroup(identifier string) (*PortGroupResponse, error) {
+	return c.GetPortGroupWithContext(context.Background(), identifier)
+}
+
+// GetPortGroupWithContext is like GetPortGroup, but uses the provided context for the request.
+func (c *Client) GetPortGroupWithContext(ctx context.Context, identifier string) (*PortGroupResponse, error) {
+	resp, err := c.GetPortGroupsWithContext(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	singleGroupResp := &PortGroupResponse{
+		Groups: make([]PortGroup, 0),
+	}
+	singleGroupResp.Message = resp.Message
+	singleGroupResp.Status = resp.Status
+
+	for _, group := range resp.Groups {
+		if group.Name == identifier || strconv.Itoa(group.ID) == identifier {
+			singleGroupResp.Groups = append(singleGroupResp.Groups, group)
+			singleGroupResp.Count = 1
+			break
+		}
+	}
+
+	return singleGroupResp, nil
+}
+
+// GetPortGroupMembers retrieves the ports of a port group. The identifier is the group name.
+// Only the port IDs are returned unless full is true, in which case all port columns are returned.
+//
+// Documentation: https://docs.librenms.org/API/PortGroups/#get_ports_by_group
+func (c *Client) GetPortGroupMembers(identifier string, full bool) (*PortResponse, error) {
+	return c.GetPortGroupMembersWithContext(context.Background(), identifier, full)
+}
+
+// GetPortGroupMembersWithContext is like GetPortGroupMembers, but uses the provided context for the request.
+func (c *Client) GetPortGroupMembersWithContext(ctx context.Context, identifier string, full bool) (*PortResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("port group identifier is required")
+	}
+
+	var params *url.Values
+	if full {
+		// the API only checks that the parameter is present
+		params = &url.Values{"full": {"1"}}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", portGroupEndpoint, url.PathEscape(identifier)), nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetPortGroups retrieves a list of port groups from the LibreNMS API.
+//
+// Documentation: https://docs.librenms.org/API/PortGroups/#get_port_groups
+func (c *Client) GetPortGroups() (*PortGroupResponse, error) {
+	return c.GetPortGroupsWithContext(context.Background())
+}
+
+// GetPortGroupsWithContext is like GetPortGroups, but uses the provided context for the request.
+func (c *Client) GetPortGroupsWithContext(ctx context.Context) (*PortGroupResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, portGroupEndpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PortGroupResponse)
+	return resp, c.do(req, resp)
+}
+
+// RemovePortGroup removes ports from a port group by their port IDs.
+// The group itself is not deleted.
+//
+// Documentation: https://docs.librenms.org/API/PortGroups/#remove_port_group
+func (c *Client) RemovePortGroup(groupID int, portIDs []int) (*BaseResponse, error) {
+	return c.RemovePortGroupWithContext(context.Background(), groupID, portIDs)
+}
+
+// RemovePortGroupWithContext is like RemovePortGroup, but uses the provided context for the request.
+func (c *Client) RemovePortGroupWithContext(ctx context.Context, groupID int, portIDs []int) (*BaseResponse, error) {
+	return c.updatePortGroupPorts(ctx, groupID, "remove", portIDs)
+}
+
+// updatePortGroupPorts assigns ports to or removes ports from a port group, depending on the action.
+func (c *Client) updatePortGroupPorts(ctx context.Context, groupID int, action string, portIDs []int) (*BaseResponse, error) {
+	if len(portIDs) == 0 {
+		return nil, errors.New("at least one port ID is required")
+	}
+
+	payload := &portGroupPortsRequest{PortIDs: portIDs}
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%d/%s", portGroupEndpoint, groupID, action), payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
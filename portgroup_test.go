@@ -0,0 +1,159 @@
+package librenms_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointPortGroupAssign  = "/api/v0/port_groups/1/assign"
+	testEndpointPortGroupMembers = "/api/v0/port_groups/Customer%20A"
+	testEndpointPortGroupRemove  = "/api/v0/port_groups/1/remove"
+	testEndpointPortGroups       = "/api/v0/port_groups"
+)
+
+// portGroupPortsHandler verifies the port IDs of assign and remove requests before writing the response.
+func portGroupPortsHandler(path, response string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			notImplemented(path, w, r)
+			return
+		}
+
+		var payload struct {
+			PortIDs []int `json:"port_ids"`
+		}
+		if json.NewDecoder(r.Body).Decode(&payload) != nil || len(payload.PortIDs) != 2 {
+			http.Error(w, `{"status": "error", "message": "unexpected payload"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(loadMockResponse(response))
+		handleWriteErr(err, w)
+	}
+}
+
+// This init function will register handlers for port group API endpoints.
+func init() {
+	// Registering this endpoint outside of handleEndpoint() to mock the HTTP 201 POST response.
+	mux.HandleFunc(testEndpointPortGroups, func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_, err = w.Write(loadMockResponse("get_port_groups_200.json"))
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, err = w.Write(loadMockResponse("add_port_group_201.json"))
+		default:
+			notImplemented(testEndpointPortGroups, w, r)
+			return
+		}
+		handleWriteErr(err, w)
+	})
+
+	// Registering this endpoint outside of handleEndpoint() to verify the query parameters.
+	mux.HandleFunc(testEndpointPortGroupMembers, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			notImplemented(testEndpointPortGroupMembers, w, r)
+			return
+		}
+		if r.URL.Query().Has("full") {
+			http.Error(w, `{"status": "error", "message": "unexpected query"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(loadMockResponse("get_ports_by_group_200.json"))
+		handleWriteErr(err, w)
+	})
+
+	mux.HandleFunc(testEndpointPortGroupAssign, portGroupPortsHandler(testEndpointPortGroupAssign, "assign_port_group_200.json"))
+	mux.HandleFunc(testEndpointPortGroupRemove, portGroupPortsHandler(testEndpointPortGroupRemove, "remove_port_group_200.json"))
+}
+
+func TestClient_GetPortGroups(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetPortGroups()
+
+	r.NoError(err, "GetPortGroups returned an error")
+	r.NotNil(resp, "GetPortGroups response is nil")
+	r.Equal(2, resp.Count, "Expected count 2")
+	r.Len(resp.Groups, 2, "Expected 2 port groups")
+	r.Equal("Customer A", resp.Groups[0].Name, "Unexpected group name")
+	r.Equal("Customer A transit aggregate", *resp.Groups[0].Description, "Unexpected group description")
+	r.Nil(resp.Groups[1].Description, "Expected nil description")
+}
+
+func TestClient_GetPortGroup(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetPortGroup("Peering")
+
+	r.NoError(err, "GetPortGroup returned an error")
+	r.Equal(1, resp.Count, "Expected count 1")
+	r.Equal(2, resp.Groups[0].ID, "Expected GroupID 2")
+
+	resp, err = testAPIClient.GetPortGroup("1")
+	r.NoError(err, "GetPortGroup returned an error for an ID")
+	r.Equal("Customer A", resp.Groups[0].Name, "Expected Group 'Customer A'")
+
+	resp, err = testAPIClient.GetPortGroup("missing")
+	r.NoError(err, "GetPortGroup returned an error for a missing group")
+	r.Empty(resp.Groups, "Expected no port groups")
+}
+
+func TestClient_GetPortGroupMembers(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetPortGroupMembers("Customer A", false)
+
+	r.NoError(err, "GetPortGroupMembers returned an error")
+	r.Len(resp.Ports, 2, "Expected 2 ports")
+	r.Equal(3, resp.Ports[0].PortID, "Unexpected port ID")
+
+	_, err = testAPIClient.GetPortGroupMembers("", false)
+	r.Error(err, "Expected error for empty identifier")
+}
+
+func TestClient_CreatePortGroup(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	desc := "Customer B transit aggregate"
+	resp, err := testAPIClient.CreatePortGroup(&librenms.PortGroupCreateRequest{Name: "Customer B", Description: &desc})
+
+	r.NoError(err, "CreatePortGroup returned an error")
+	r.Equal(3, resp.ID, "Expected GroupID 3")
+
+	_, err = testAPIClient.CreatePortGroup(&librenms.PortGroupCreateRequest{})
+	r.Error(err, "Expected error for missing name")
+}
+
+func TestClient_AssignRemovePortGroup(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.AssignPortGroup(1, []int{5, 6})
+	r.NoError(err, "AssignPortGroup returned an error")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+
+	resp, err = testAPIClient.RemovePortGroup(1, []int{5, 6})
+	r.NoError(err, "RemovePortGroup returned an error")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+
+	_, err = testAPIClient.AssignPortGroup(1, nil)
+	r.Error(err, "Expected error for no port IDs")
+}
@@ -0,0 +1,205 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type (
+	// RetryPolicy configures how the client retries failed requests.
+	//
+	// A request is retried when the transport returns an error (e.g. a connection reset) or
+	// the response status code is listed in RetryableStatusCodes, as long as the request method
+	// is listed in RetryableMethods. Non-idempotent methods such as POST are not retried by
+	// default, so a CreateDevice call will not be replayed unless explicitly allowed.
+	RetryPolicy struct {
+		// MaxAttempts is the total number of attempts, including the first one.
+		// A value of 1 or less disables retries.
+		MaxAttempts int
+		// MinBackoff is the wait time before the first retry. It doubles with each attempt.
+		MinBackoff time.Duration
+		// MaxBackoff caps the wait time between attempts, including waits requested by Retry-After.
+		MaxBackoff time.Duration
+		// Jitter randomizes each wait time by up to +/- the given fraction (0.0 - 1.0).
+		Jitter float64
+		// RetryableStatusCodes lists the HTTP status codes that should be retried.
+		RetryableStatusCodes []int
+		// RetryableMethods lists the HTTP methods that are safe to retry.
+		RetryableMethods []string
+		// RespectRetryAfter uses the Retry-After response header, if present, as the wait time.
+		RespectRetryAfter bool
+		// OnAttempt, if set, is called after every attempt with the outcome of that attempt.
+		OnAttempt func(RetryAttempt)
+	}
+
+	// RetryAttempt describes the outcome of a single request attempt, passed to RetryPolicy.OnAttempt.
+	RetryAttempt struct {
+		// Attempt is the 1-based attempt number.
+		Attempt int
+		// Request is the request that was sent.
+		Request *http.Request
+		// Response is the response received, or nil if the transport returned an error.
+		// The body must not be read by the hook.
+		Response *http.Response
+		// Err is the transport or API error for this attempt, if any.
+		Err error
+		// Retrying indicates whether the client will make another attempt.
+		Retrying bool
+		// Wait is the time the client will wait before the next attempt.
+		Wait time.Duration
+	}
+)
+
+// DefaultRetryPolicy returns a RetryPolicy with sensible defaults: 3 attempts, exponential
+// backoff from 500ms up to 30s with 20% jitter, retrying 429/502/503/504 responses on
+// idempotent methods only.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableMethods: []string{
+			http.MethodDelete,
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodPut,
+		},
+		RespectRetryAfter: true,
+	}
+}
+
+// WithRetryPolicy sets the retry policy for the LibreNMS client.
+// By default, requests are not retried. Passing nil disables retries.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// maxAttempts returns the effective number of attempts for the policy.
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// shouldRetry reports whether the request should be attempted again given the outcome of
+// the current attempt.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if attempt >= p.maxAttempts() {
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	if !slices.Contains(p.RetryableMethods, req.Method) {
+		return false
+	}
+	// a body that cannot be rewound cannot be replayed
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if resp == nil {
+		// transport error; don't retry if it's the caller's context giving up
+		return err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return slices.Contains(p.RetryableStatusCodes, resp.StatusCode)
+}
+
+// backoff returns the time to wait before the next attempt.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if p.RespectRetryAfter && resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+				wait = p.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	wait := p.MinBackoff
+	for i := 1; i < attempt; i++ {
+		wait *= 2
+		if p.MaxBackoff > 0 && wait >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 && wait > 0 {
+		delta := float64(wait) * p.Jitter
+		wait += time.Duration(delta * (2*rand.Float64() - 1))
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// rewindRequest returns a copy of the request with a fresh body, so it can be sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	newReq := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		newReq.Body = body
+	}
+	return newReq, nil
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
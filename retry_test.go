@@ -0,0 +1,96 @@
+package librenms_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+// newRetryTestClient creates a client against a dedicated test server which fails with
+// the given status code until the configured number of failures has been served.
+func newRetryTestClient(t *testing.T, failures int32, status int, policy *librenms.RetryPolicy) (*librenms.Client, *atomic.Int32) {
+	t.Helper()
+
+	calls := new(atomic.Int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"status": "error", "message": "backend unavailable"}`))
+			return
+		}
+		_, _ = w.Write(loadMockResponse("get_device_200.json"))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := librenms.New(server.URL+"/", "test-token", librenms.WithRetryPolicy(policy))
+	require.NoError(t, err)
+	return client, calls
+}
+
+func testRetryPolicy() *librenms.RetryPolicy {
+	policy := librenms.DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestClient_Retry_Succeeds(t *testing.T) {
+	r := require.New(t)
+
+	var attempts []librenms.RetryAttempt
+	policy := testRetryPolicy()
+	policy.OnAttempt = func(a librenms.RetryAttempt) {
+		attempts = append(attempts, a)
+	}
+
+	client, calls := newRetryTestClient(t, 2, http.StatusBadGateway, policy)
+
+	deviceResp, err := client.GetDevice("1.1.1.1")
+	r.NoError(err, "GetDevice returned an error")
+	r.Len(deviceResp.Devices, 1, "Expected 1 device")
+	r.Equal(int32(3), calls.Load(), "Expected 3 calls to the server")
+
+	r.Len(attempts, 3, "Expected OnAttempt to be called for each attempt")
+	r.True(attempts[0].Retrying, "Expected first attempt to be retried")
+	r.Equal(http.StatusBadGateway, attempts[0].Response.StatusCode, "Expected 502 on first attempt")
+	r.False(attempts[2].Retrying, "Expected last attempt not to be retried")
+	r.NoError(attempts[2].Err, "Expected last attempt to succeed")
+}
+
+func TestClient_Retry_Exhausted(t *testing.T) {
+	r := require.New(t)
+
+	client, calls := newRetryTestClient(t, 5, http.StatusServiceUnavailable, testRetryPolicy())
+
+	_, err := client.GetDevice("1.1.1.1")
+	r.Error(err, "Expected error after retries are exhausted")
+	r.ErrorContains(err, "backend unavailable", "Expected API error message")
+	r.Equal(int32(3), calls.Load(), "Expected MaxAttempts calls to the server")
+}
+
+func TestClient_Retry_NonIdempotent(t *testing.T) {
+	r := require.New(t)
+
+	client, calls := newRetryTestClient(t, 1, http.StatusBadGateway, testRetryPolicy())
+
+	_, err := client.CreateDevice(&librenms.DeviceCreateRequest{Hostname: "192.168.10.5"})
+	r.Error(err, "Expected POST not to be retried")
+	r.Equal(int32(1), calls.Load(), "Expected a single call to the server")
+}
+
+func TestClient_Retry_StatusNotRetryable(t *testing.T) {
+	r := require.New(t)
+
+	client, calls := newRetryTestClient(t, 1, http.StatusNotFound, testRetryPolicy())
+
+	_, err := client.GetDevice("1.1.1.1")
+	r.Error(err, "Expected 404 not to be retried")
+	r.Equal(int32(1), calls.Load(), "Expected a single call to the server")
+}
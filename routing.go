@@ -0,0 +1,468 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	bgpEndpoint       = "bgp"
+	cbgpEndpoint      = "routing/bgp/cbgp"
+	ipsecEndpoint     = "routing/ipsec/data"
+	ospfEndpoint      = "ospf"
+	ospfPortsEndpoint = "ospf_ports"
+	slaEndpoint       = "slas"
+	vrfEndpoint       = "routing/vrf"
+)
+
+type (
+	// BGPSession represents a BGP peering session in LibreNMS.
+	BGPSession struct {
+		ID       int `json:"bgpPeer_id"`
+		DeviceID int `json:"device_id"`
+
+		AdminStatus         string  `json:"bgpPeerAdminStatus"` // start, stop
+		ASText              *string `json:"astext"`
+		ContextName         *string `json:"context_name"`
+		Description         string  `json:"bgpPeerDescr"`
+		FsmEstablishedTime  int     `json:"bgpPeerFsmEstablishedTime"`
+		Iface               *int    `json:"bgpPeerIface"`
+		InTotalMessages     int     `json:"bgpPeerInTotalMessages"`
+		InUpdateElapsedTime int     `json:"bgpPeerInUpdateElapsedTime"`
+		InUpdates           int     `json:"bgpPeerInUpdates"`
+		LastErrorCode       *int    `json:"bgpPeerLastErrorCode"`
+		LastErrorSubCode    *int    `json:"bgpPeerLastErrorSubCode"`
+		LastErrorText       *string `json:"bgpPeerLastErrorText"`
+		LocalAddress        string  `json:"bgpLocalAddr"`
+		OutTotalMessages    int     `json:"bgpPeerOutTotalMessages"`
+		OutUpdates          int     `json:"bgpPeerOutUpdates"`
+		PeerIdentifier      string  `json:"bgpPeerIdentifier"`
+		RemoteAddress       string  `json:"bgpPeerRemoteAddr"`
+		RemoteAS            int     `json:"bgpPeerRemoteAs"`
+		State               string  `json:"bgpPeerState"` // idle, connect, active, opensent, openconfirm, established
+		VrfID               *int    `json:"vrf_id"`
+	}
+
+	// BGPSessionResponse represents a response containing a list of BGP sessions.
+	BGPSessionResponse struct {
+		BaseResponse
+		Sessions []BGPSession `json:"bgp_sessions"`
+	}
+
+	// bgpSessionResponse is the internal response structure for a single BGP session, which
+	// uses the key "bgp_session" rather than "bgp_sessions". It's normalized into a BGPSessionResponse.
+	bgpSessionResponse struct {
+		BaseResponse
+		Session []BGPSession `json:"bgp_session"`
+	}
+
+	// BGPSessionsQuery represents the query parameters for filtering GetBGPSessions().
+	BGPSessionsQuery struct {
+		ASN           int    `url:"asn,omitempty"` // local AS number
+		AdminState    string `url:"bgp_adminstate,omitempty"`
+		Description   string `url:"bgp_descr,omitempty"`  // partial match
+		Family        int    `url:"bgp_family,omitempty"` // 4 or 6
+		Hostname      string `url:"hostname,omitempty"`
+		LocalAddress  string `url:"local_address,omitempty"`
+		RemoteAddress string `url:"remote_address,omitempty"`
+		RemoteASN     int    `url:"remote_asn,omitempty"`
+		State         string `url:"bgp_state,omitempty"`
+	}
+
+	// bgpDescriptionRequest is the request payload for updating a BGP session description.
+	bgpDescriptionRequest struct {
+		Description string `json:"bgp_descr"`
+	}
+
+	// BGPCounter represents the per address family prefix counters of a BGP session.
+	BGPCounter struct {
+		DeviceID int `json:"device_id"`
+
+		AcceptedPrefixes     int     `json:"AcceptedPrefixes"`
+		AdvertisedPrefixes   int     `json:"AdvertisedPrefixes"`
+		AFI                  string  `json:"afi"` // ipv4, ipv6
+		ContextName          *string `json:"context_name"`
+		DeniedPrefixes       int     `json:"DeniedPrefixes"`
+		PeerIdentifier       string  `json:"bgpPeerIdentifier"`
+		PrefixAdminLimit     int     `json:"PrefixAdminLimit"`
+		PrefixClearThreshold int     `json:"PrefixClearThreshold"`
+		PrefixThreshold      int     `json:"PrefixThreshold"`
+		SAFI                 string  `json:"safi"` // unicast, multicast, vpn
+		SuppressedPrefixes   int     `json:"SuppressedPrefixes"`
+		WithdrawnPrefixes    int     `json:"WithdrawnPrefixes"`
+	}
+
+	// BGPCounterResponse represents a response containing a list of BGP prefix counters.
+	BGPCounterResponse struct {
+		BaseResponse
+		Counters []BGPCounter `json:"bgp_counters"`
+	}
+
+	// IPsecTunnel represents an IPsec tunnel of a device.
+	IPsecTunnel struct {
+		ID       int `json:"tunnel_id"`
+		DeviceID int `json:"device_id"`
+
+		LocalAddress string  `json:"local_addr"`
+		LocalPort    int     `json:"local_port"`
+		Name         string  `json:"tunnel_name"`
+		PeerAddress  string  `json:"peer_addr"`
+		PeerPort     int     `json:"peer_port"`
+		Status       string  `json:"tunnel_status"` // active, inactive
+		LastSeen     *string `json:"tunnel_lastseen"`
+	}
+
+	// IPsecTunnelResponse represents a response containing the IPsec tunnels of a device.
+	IPsecTunnelResponse struct {
+		BaseResponse
+		Tunnels []IPsecTunnel `json:"ipsec"`
+	}
+
+	// OSPFNeighbour represents an OSPF neighbour of a device.
+	OSPFNeighbour struct {
+		ID       int  `json:"id"`
+		DeviceID int  `json:"device_id"`
+		PortID   *int `json:"port_id"`
+
+		AddressLessIndex int     `json:"ospfNbrAddressLessIndex"`
+		ContextName      *string `json:"context_name"`
+		Events           int     `json:"ospfNbrEvents"`
+		HelloSuppressed  string  `json:"ospfNbrHelloSuppressed"`
+		IPAddress        string  `json:"ospfNbrIpAddr"`
+		LsRetransQLen    int     `json:"ospfNbrLsRetransQLen"`
+		NbmaPermanence   string  `json:"ospfNbmaNbrPermanence"`
+		NbmaStatus       string  `json:"ospfNbmaNbrStatus"`
+		NeighbourID      string  `json:"ospf_nbr_id"`
+		Options          int     `json:"ospfNbrOptions"`
+		Priority         int     `json:"ospfNbrPriority"`
+		RouterID         string  `json:"ospfNbrRtrId"`
+		State            string  `json:"ospfNbrState"` // down, attempt, init, twoWay, exchangeStart, exchange, loading, full
+	}
+
+	// OSPFNeighbourResponse represents a response containing a list of OSPF neighbours.
+	OSPFNeighbourResponse struct {
+		BaseResponse
+		Neighbours []OSPFNeighbour `json:"ospf_neighbours"`
+	}
+
+	// OSPFPort represents an OSPF enabled interface of a device.
+	OSPFPort struct {
+		ID       int  `json:"id"`
+		DeviceID int  `json:"device_id"`
+		PortID   *int `json:"port_id"`
+
+		AddressLessIf          int     `json:"ospfAddressLessIf"`
+		AdminStat              string  `json:"ospfIfAdminStat"`
+		AreaID                 string  `json:"ospfIfAreaId"`
+		AuthType               *string `json:"ospfIfAuthType"`
+		BackupDesignatedRouter string  `json:"ospfIfBackupDesignatedRouter"`
+		ContextName            *string `json:"context_name"`
+		DesignatedRouter       string  `json:"ospfIfDesignatedRouter"`
+		Events                 int     `json:"ospfIfEvents"`
+		HelloInterval          int     `json:"ospfIfHelloInterval"`
+		IPAddress              string  `json:"ospfIfIpAddress"`
+		MetricValue            *int    `json:"ospfIfMetricValue"`
+		OSPFPortID             string  `json:"ospf_port_id"`
+		RetransInterval        int     `json:"ospfIfRetransInterval"`
+		RtrDeadInterval        int     `json:"ospfIfRtrDeadInterval"`
+		RtrPriority            int     `json:"ospfIfRtrPriority"`
+		State                  string  `json:"ospfIfState"` // down, loopback, waiting, pointToPoint, designatedRouter, backupDesignatedRouter, otherDesignatedRouter
+		Status                 string  `json:"ospfIfStatus"`
+		TransitDelay           int     `json:"ospfIfTransitDelay"`
+		Type                   string  `json:"ospfIfType"`
+	}
+
+	// OSPFPortResponse represents a response containing a list of OSPF ports.
+	OSPFPortResponse struct {
+		BaseResponse
+		Ports []OSPFPort `json:"ospf_ports"`
+	}
+
+	// RoutingQuery represents the query parameters for filtering routing lists by device.
+	RoutingQuery struct {
+		Hostname string `url:"hostname,omitempty"`
+	}
+
+	// SLA represents an IP SLA probe of a device.
+	SLA struct {
+		ID       int `json:"sla_id"`
+		DeviceID int `json:"device_id"`
+
+		Deleted  Bool     `json:"deleted"`
+		Number   int      `json:"sla_nr"`
+		OpStatus int      `json:"opstatus"` // 0 ok, 1 warning, 2 critical
+		Owner    string   `json:"owner"`
+		RTT      *Float64 `json:"rtt"`
+		RTTType  string   `json:"rtt_type"` // e.g. echo, jitter, icmpjitter, http
+		Status   Bool     `json:"status"`
+		Tag      string   `json:"tag"`
+	}
+
+	// SLAResponse represents a response containing a list of SLAs.
+	SLAResponse struct {
+		BaseResponse
+		SLAs []SLA `json:"slas"`
+	}
+
+	// VRF represents a VRF of a device.
+	VRF struct {
+		ID       int `json:"vrf_id"`
+		DeviceID int `json:"device_id"`
+
+		BGPLocalAS         *int    `json:"bgpLocalAs"`
+		Description        *string `json:"mplsVpnVrfDescription"`
+		Name               string  `json:"vrf_name"`
+		OID                string  `json:"vrf_oid"`
+		RouteDistinguisher *string `json:"mplsVpnVrfRouteDistinguisher"`
+	}
+
+	// VRFResponse represents a response containing a list of VRFs.
+	VRFResponse struct {
+		BaseResponse
+		VRFs []VRF `json:"vrfs"`
+	}
+
+	// vrfInfoResponse is the internal response structure for a single VRF, which
+	// uses the key "vrf" rather than "vrfs". It's normalized into a VRFResponse.
+	vrfInfoResponse struct {
+		BaseResponse
+		VRF []VRF `json:"vrf"`
+	}
+
+	// VRFsQuery represents the query parameters for filtering GetVRFs().
+	VRFsQuery struct {
+		Hostname string `url:"hostname,omitempty"`
+		VRFName  string `url:"vrfname,omitempty"`
+	}
+)
+
+// GetBGPCounters retrieves the per address family prefix counters of BGP sessions,
+// optionally filtered by device.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_cbgp
+func (c *Client) GetBGPCounters(query *RoutingQuery) (*BGPCounterResponse, error) {
+	return c.GetBGPCountersWithContext(context.Background(), query)
+}
+
+// GetBGPCountersWithContext is like GetBGPCounters, but uses the provided context for the request.
+func (c *Client) GetBGPCountersWithContext(ctx context.Context, query *RoutingQuery) (*BGPCounterResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, cbgpEndpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BGPCounterResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetBGPSession retrieves a BGP session by its ID.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#get_bgp
+func (c *Client) GetBGPSession(sessionID int) (*BGPSessionResponse, error) {
+	return c.GetBGPSessionWithContext(context.Background(), sessionID)
+}
+
+// GetBGPSessionWithContext is like GetBGPSession, but uses the provided context for the request.
+func (c *Client) GetBGPSessionWithContext(ctx context.Context, sessionID int) (*BGPSessionResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d", bgpEndpoint, sessionID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	internalResp := new(bgpSessionResponse)
+	if err = c.do(req, internalResp); err != nil {
+		return nil, err
+	}
+
+	return &BGPSessionResponse{
+		BaseResponse: BaseResponse{
+			Status:  internalResp.Status,
+			Message: internalResp.Message,
+			Count:   len(internalResp.Session),
+		},
+		Sessions: internalResp.Session,
+	}, nil
+}
+
+// GetBGPSessions retrieves a list of BGP sessions, optionally filtered by the query.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_bgp
+func (c *Client) GetBGPSessions(query *BGPSessionsQuery) (*BGPSessionResponse, error) {
+	return c.GetBGPSessionsWithContext(context.Background(), query)
+}
+
+// GetBGPSessionsWithContext is like GetBGPSessions, but uses the provided context for the request.
+func (c *Client) GetBGPSessionsWithContext(ctx context.Context, query *BGPSessionsQuery) (*BGPSessionResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, bgpEndpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BGPSessionResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetIPsecTunnels retrieves the IPsec tunnels of a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_ipsec
+func (c *Client) GetIPsecTunnels(identifier string) (*IPsecTunnelResponse, error) {
+	return c.GetIPsecTunnelsWithContext(context.Background(), identifier)
+}
+
+// GetIPsecTunnelsWithContext is like GetIPsecTunnels, but uses the provided context for the request.
+func (c *Client) GetIPsecTunnelsWithContext(ctx context.Context, identifier string) (*IPsecTunnelResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", ipsecEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(IPsecTunnelResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetOSPFNeighbours retrieves a list of OSPF neighbours, optionally filtered by device.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_ospf
+func (c *Client) GetOSPFNeighbours(query *RoutingQuery) (*OSPFNeighbourResponse, error) {
+	return c.GetOSPFNeighboursWithContext(context.Background(), query)
+}
+
+// GetOSPFNeighboursWithContext is like GetOSPFNeighbours, but uses the provided context for the request.
+func (c *Client) GetOSPFNeighboursWithContext(ctx context.Context, query *RoutingQuery) (*OSPFNeighbourResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, ospfEndpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(OSPFNeighbourResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetOSPFPorts retrieves a list of all OSPF enabled interfaces.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_ospf_ports
+func (c *Client) GetOSPFPorts() (*OSPFPortResponse, error) {
+	return c.GetOSPFPortsWithContext(context.Background())
+}
+
+// GetOSPFPortsWithContext is like GetOSPFPorts, but uses the provided context for the request.
+func (c *Client) GetOSPFPortsWithContext(ctx context.Context) (*OSPFPortResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, ospfPortsEndpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(OSPFPortResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetSLAs retrieves a list of all IP SLA probes.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_sla
+func (c *Client) GetSLAs() (*SLAResponse, error) {
+	return c.GetSLAsWithContext(context.Background())
+}
+
+// GetSLAsWithContext is like GetSLAs, but uses the provided context for the request.
+func (c *Client) GetSLAsWithContext(ctx context.Context) (*SLAResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, slaEndpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SLAResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetVRF retrieves a VRF by its ID.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#get_vrf
+func (c *Client) GetVRF(vrfID int) (*VRFResponse, error) {
+	return c.GetVRFWithContext(context.Background(), vrfID)
+}
+
+// GetVRFWithContext is like GetVRF, but uses the provided context for the request.
+func (c *Client) GetVRFWithContext(ctx context.Context, vrfID int) (*VRFResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d", vrfEndpoint, vrfID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	internalResp := new(vrfInfoResponse)
+	if err = c.do(req, internalResp); err != nil {
+		return nil, err
+	}
+
+	return &VRFResponse{
+		BaseResponse: BaseResponse{
+			Status:  internalResp.Status,
+			Message: internalResp.Message,
+			Count:   len(internalResp.VRF),
+		},
+		VRFs: internalResp.VRF,
+	}, nil
+}
+
+// GetVRFs retrieves a list of VRFs, optionally filtered by the query.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#list_vrf
+func (c *Client) GetVRFs(query *VRFsQuery) (*VRFResponse, error) {
+	return c.GetVRFsWithContext(context.Background(), query)
+}
+
+// GetVRFsWithContext is like GetVRFs, but uses the provided context for the request.
+func (c *Client) GetVRFsWithContext(ctx context.Context, query *VRFsQuery) (*VRFResponse, error) {
+	params, err := parseParams(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, vrfEndpoint, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(VRFResponse)
+	return resp, c.do(req, resp)
+}
+
+// UpdateBGPSessionDescription updates the description of a BGP session by its ID.
+//
+// Documentation: https://docs.librenms.org/API/Routing/#edit_bgp_descr
+func (c *Client) UpdateBGPSessionDescription(sessionID int, description string) (*BaseResponse, error) {
+	return c.UpdateBGPSessionDescriptionWithContext(context.Background(), sessionID, description)
+}
+
+// UpdateBGPSessionDescriptionWithContext is like UpdateBGPSessionDescription, but uses the provided context for the request.
+func (c *Client) UpdateBGPSessionDescriptionWithContext(ctx context.Context, sessionID int, description string) (*BaseResponse, error) {
+	payload := bgpDescriptionRequest{Description: description}
+
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%d", bgpEndpoint, sessionID), payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
@@ -0,0 +1,219 @@
+package librenms_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointBGPCounters = "/api/v0/routing/bgp/cbgp"
+	testEndpointBGPSession  = "/api/v0/bgp/1"
+	testEndpointBGPSessions = "/api/v0/bgp"
+	testEndpointIPsec       = "/api/v0/routing/ipsec/data/1.1.1.1"
+	testEndpointOSPF        = "/api/v0/ospf"
+	testEndpointOSPFPorts   = "/api/v0/ospf_ports"
+	testEndpointSLAs        = "/api/v0/slas"
+	testEndpointVRF         = "/api/v0/routing/vrf/1"
+	testEndpointVRFs        = "/api/v0/routing/vrf"
+)
+
+// This init function will register handlers for routing API endpoints.
+func init() {
+	// Registering this endpoint outside of handleEndpoint() to verify the query parameters.
+	mux.HandleFunc(testEndpointBGPSessions, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("hostname") != "1.1.1.1" || q.Get("bgp_state") != "established" || q.Get("bgp_family") != "4" || q.Has("asn") {
+			http.Error(w, `{"status": "error", "message": "unexpected query"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(loadMockResponse("get_bgp_sessions_200.json"))
+		handleWriteErr(err, w)
+	})
+
+	// Registering this endpoint outside of handleEndpoint() to verify the request payload.
+	mux.HandleFunc(testEndpointBGPSession, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			_, err = w.Write(loadMockResponse("get_bgp_session_200.json"))
+		case http.MethodPost:
+			var payload map[string]string
+			if json.NewDecoder(r.Body).Decode(&payload) != nil || payload["bgp_descr"] != "Transit A - circuit 42" {
+				http.Error(w, `{"status": "error", "message": "unexpected payload"}`, http.StatusBadRequest)
+				return
+			}
+			_, err = w.Write(loadMockResponse("update_bgp_descr_200.json"))
+		default:
+			notImplemented(testEndpointBGPSession, w, r)
+			return
+		}
+		handleWriteErr(err, w)
+	})
+
+	handleEndpoint(testEndpointBGPCounters, mockResponses{
+		http.MethodGet: loadMockResponse("get_cbgp_200.json"),
+	})
+
+	handleEndpoint(testEndpointIPsec, mockResponses{
+		http.MethodGet: loadMockResponse("get_ipsec_200.json"),
+	})
+
+	handleEndpoint(testEndpointOSPF, mockResponses{
+		http.MethodGet: loadMockResponse("get_ospf_200.json"),
+	})
+
+	handleEndpoint(testEndpointOSPFPorts, mockResponses{
+		http.MethodGet: loadMockResponse("get_ospf_ports_200.json"),
+	})
+
+	handleEndpoint(testEndpointSLAs, mockResponses{
+		http.MethodGet: loadMockResponse("get_slas_200.json"),
+	})
+
+	handleEndpoint(testEndpointVRF, mockResponses{
+		http.MethodGet: loadMockResponse("get_vrf_200.json"),
+	})
+
+	handleEndpoint(testEndpointVRFs, mockResponses{
+		http.MethodGet: loadMockResponse("get_vrfs_200.json"),
+	})
+}
+
+func TestClient_GetBGPSessions(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetBGPSessions(&librenms.BGPSessionsQuery{
+		Hostname: "1.1.1.1",
+		State:    "established",
+		Family:   4,
+	})
+
+	r.NoError(err, "GetBGPSessions returned an error")
+	r.NotNil(resp, "GetBGPSessions response is nil")
+	r.Len(resp.Sessions, 2, "Expected 2 BGP sessions")
+
+	session := resp.Sessions[0]
+	r.Equal(1, session.ID, "Unexpected session ID")
+	r.Equal(64500, session.RemoteAS, "Unexpected remote AS")
+	r.Equal("established", session.State, "Unexpected state")
+	r.Equal("EXAMPLE-TRANSIT", *session.ASText, "Unexpected AS text")
+	r.Nil(session.VrfID, "Expected nil VRF ID")
+	r.Equal("administrative shutdown", *resp.Sessions[1].LastErrorText, "Unexpected last error text")
+}
+
+func TestClient_GetBGPSession(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetBGPSession(1)
+
+	r.NoError(err, "GetBGPSession returned an error")
+	r.NotNil(resp, "GetBGPSession response is nil")
+	r.Equal(1, resp.Count, "Expected count 1")
+	r.Len(resp.Sessions, 1, "Expected 1 BGP session")
+	r.Equal("192.0.2.1", resp.Sessions[0].RemoteAddress, "Unexpected remote address")
+}
+
+func TestClient_UpdateBGPSessionDescription(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.UpdateBGPSessionDescription(1, "Transit A - circuit 42")
+
+	r.NoError(err, "UpdateBGPSessionDescription returned an error")
+	r.NotNil(resp, "UpdateBGPSessionDescription response is nil")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+}
+
+func TestClient_GetBGPCounters(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetBGPCounters(&librenms.RoutingQuery{Hostname: "1.1.1.1"})
+
+	r.NoError(err, "GetBGPCounters returned an error")
+	r.Len(resp.Counters, 1, "Expected 1 counter")
+	r.Equal("ipv4", resp.Counters[0].AFI, "Unexpected AFI")
+	r.Equal(950000, resp.Counters[0].AcceptedPrefixes, "Unexpected accepted prefixes")
+}
+
+func TestClient_GetOSPF(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	neighbours, err := testAPIClient.GetOSPFNeighbours(nil)
+
+	r.NoError(err, "GetOSPFNeighbours returned an error")
+	r.Len(neighbours.Neighbours, 1, "Expected 1 neighbour")
+	r.Equal("full", neighbours.Neighbours[0].State, "Unexpected neighbour state")
+	r.Equal("10.255.0.2", neighbours.Neighbours[0].RouterID, "Unexpected router ID")
+	r.Equal(3, *neighbours.Neighbours[0].PortID, "Unexpected port ID")
+
+	ports, err := testAPIClient.GetOSPFPorts()
+
+	r.NoError(err, "GetOSPFPorts returned an error")
+	r.Len(ports.Ports, 1, "Expected 1 OSPF port")
+	r.Equal("0.0.0.0", ports.Ports[0].AreaID, "Unexpected area ID")
+	r.Equal(10, *ports.Ports[0].MetricValue, "Unexpected metric")
+}
+
+func TestClient_GetVRFs(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetVRFs(&librenms.VRFsQuery{Hostname: "1.1.1.1"})
+
+	r.NoError(err, "GetVRFs returned an error")
+	r.Len(resp.VRFs, 2, "Expected 2 VRFs")
+	r.Equal("64496:100", *resp.VRFs[0].RouteDistinguisher, "Unexpected route distinguisher")
+	r.Nil(resp.VRFs[1].BGPLocalAS, "Expected nil local AS")
+
+	resp, err = testAPIClient.GetVRF(1)
+
+	r.NoError(err, "GetVRF returned an error")
+	r.Equal(1, resp.Count, "Expected count 1")
+	r.Equal("CUSTOMER", resp.VRFs[0].Name, "Unexpected VRF name")
+}
+
+func TestClient_GetIPsecTunnels(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetIPsecTunnels("1.1.1.1")
+
+	r.NoError(err, "GetIPsecTunnels returned an error")
+	r.Len(resp.Tunnels, 1, "Expected 1 tunnel")
+	r.Equal("198.51.100.7", resp.Tunnels[0].PeerAddress, "Unexpected peer address")
+	r.Equal("active", resp.Tunnels[0].Status, "Unexpected status")
+
+	_, err = testAPIClient.GetIPsecTunnels("")
+	r.Error(err, "Expected error for empty identifier")
+}
+
+func TestClient_GetSLAs(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetSLAs()
+
+	r.NoError(err, "GetSLAs returned an error")
+	r.Len(resp.SLAs, 1, "Expected 1 SLA")
+	r.Equal("echo", resp.SLAs[0].RTTType, "Unexpected RTT type")
+	r.Equal(12.5, float64(*resp.SLAs[0].RTT), "Expected RTT parsed from a string")
+	r.True(bool(resp.SLAs[0].Status), "Expected status true")
+}
@@ -1,7 +1,9 @@
 package librenms
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -72,7 +74,12 @@ type (
 //
 // Documentation: https://docs.librenms.org/API/Services/#add_service_for_host
 func (c *Client) CreateService(deviceIdentifier string, service *ServiceCreateRequest) (*ServiceResponse, error) {
-	req, err := c.newRequest(http.MethodPost, fmt.Sprintf("%s/%s", serviceEndpoint, deviceIdentifier), service, nil)
+	return c.CreateServiceWithContext(context.Background(), deviceIdentifier, service)
+}
+
+// CreateServiceWithContext is like CreateService, but uses the provided context for the request.
+func (c *Client) CreateServiceWithContext(ctx context.Context, deviceIdentifier string, service *ServiceCreateRequest) (*ServiceResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%s", serviceEndpoint, deviceIdentifier), service, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +92,12 @@ func (c *Client) CreateService(deviceIdentifier string, service *ServiceCreateRe
 //
 // Documentation: https://docs.librenms.org/API/Services/#delete_service_from_host
 func (c *Client) DeleteService(serviceID int) (*BaseResponse, error) {
-	req, err := c.newRequest(http.MethodDelete, fmt.Sprintf("%s/%d", serviceEndpoint, serviceID), nil, nil)
+	return c.DeleteServiceWithContext(context.Background(), serviceID)
+}
+
+// DeleteServiceWithContext is like DeleteService, but uses the provided context for the request.
+func (c *Client) DeleteServiceWithContext(ctx context.Context, serviceID int) (*BaseResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", serviceEndpoint, serviceID), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +112,12 @@ func (c *Client) DeleteService(serviceID int) (*BaseResponse, error) {
 // modified payload with the single host (if a match is found).
 // This is primarily a convenience function for the Terraform provider.
 func (c *Client) GetService(serviceID int) (*ServiceResponse, error) {
-	req, err := c.newRequest(http.MethodGet, serviceEndpoint, nil, nil)
+	return c.GetServiceWithContext(context.Background(), serviceID)
+}
+
+// GetServiceWithContext is like GetService, but uses the provided context for the request.
+func (c *Client) GetServiceWithContext(ctx context.Context, serviceID int) (*ServiceResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, serviceEndpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +158,12 @@ func (c *Client) GetService(serviceID int) (*ServiceResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Services/#list_services
 func (c *Client) GetServices() (*ServiceResponse, error) {
-	req, err := c.newRequest(http.MethodGet, serviceEndpoint, nil, nil)
+	return c.GetServicesWithContext(context.Background())
+}
+
+// GetServicesWithContext is like GetServices, but uses the provided context for the request.
+func (c *Client) GetServicesWithContext(ctx context.Context) (*ServiceResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, serviceEndpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +188,12 @@ func (c *Client) GetServices() (*ServiceResponse, error) {
 //
 // Documentation: https://docs.librenms.org/API/Services/#get_service_for_host
 func (c *Client) GetServicesForHost(deviceIdentifier string) (*ServiceResponse, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf("%s/%s", serviceEndpoint, deviceIdentifier), nil, nil)
+	return c.GetServicesForHostWithContext(context.Background(), deviceIdentifier)
+}
+
+// GetServicesForHostWithContext is like GetServicesForHost, but uses the provided context for the request.
+func (c *Client) GetServicesForHostWithContext(ctx context.Context, deviceIdentifier string) (*ServiceResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", serviceEndpoint, deviceIdentifier), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -187,11 +214,24 @@ func (c *Client) GetServicesForHost(deviceIdentifier string) (*ServiceResponse,
 	}, err
 }
 
+// Services returns an iterator over all services. Unlike GetServices, the response
+// is decoded one service at a time, and the nested service lists are flattened.
+//
+// Documentation: https://docs.librenms.org/API/Services/#list_services
+func (c *Client) Services(ctx context.Context) iter.Seq2[Service, error] {
+	return listSeq[Service](ctx, c, serviceEndpoint, nil, listConfig{key: "services", nested: true})
+}
+
 // UpdateService updates a service for the specified service ID.
 //
 // Documentation: https://docs.librenms.org/API/Services/#edit_service_from_host
 func (c *Client) UpdateService(serviceID int, service *ServiceUpdateRequest) (*ServiceResponse, error) {
-	req, err := c.newRequest(http.MethodPatch, fmt.Sprintf("%s/%d", serviceEndpoint, serviceID), service.payload(), nil)
+	return c.UpdateServiceWithContext(context.Background(), serviceID, service)
+}
+
+// UpdateServiceWithContext is like UpdateService, but uses the provided context for the request.
+func (c *Client) UpdateServiceWithContext(ctx context.Context, serviceID int, service *ServiceUpdateRequest) (*ServiceResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%d", serviceEndpoint, serviceID), service.payload(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -265,12 +305,7 @@ func (r *ServiceUpdateRequest) payload() map[string]interface{} {
 		payload["service_ip"] = *r.IP
 	}
 	if r.Ignore != nil {
-		payload["service_ignore"] = func() int {
-			if *r.Ignore {
-				return 1
-			}
-			return 0
-		}()
+		payload["service_ignore"] = boolToInt(*r.Ignore)
 	}
 
 	if r.Param != nil {
@@ -0,0 +1,326 @@
+package librenms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	arpEndpoint  = "resources/ip/arp"
+	fdbEndpoint  = "resources/fdb"
+	linkEndpoint = "resources/links"
+	vlanEndpoint = "resources/vlans"
+)
+
+type (
+	// ARPEntry represents an ARP table entry (IPv4 to MAC address mapping) of a device port.
+	ARPEntry struct {
+		DeviceID int `json:"device_id"`
+		PortID   int `json:"port_id"`
+
+		ContextName *string `json:"context_name"`
+		IPv4Address string  `json:"ipv4_address"`
+		MACAddress  string  `json:"mac_address"` // 12 lowercase hex digits, e.g. "da160e5c2002"
+	}
+
+	// ARPResponse represents a response containing a list of ARP entries.
+	ARPResponse struct {
+		BaseResponse
+		Entries []ARPEntry `json:"arp"`
+	}
+
+	// FDBDetail represents the switch port a MAC address was learned on, as returned by GetFDBDetail().
+	FDBDetail struct {
+		Hostname  string  `json:"hostname"`
+		IfAlias   *string `json:"ifAlias"`
+		IfDescr   *string `json:"ifDescr"`
+		IfName    *string `json:"ifName"`
+		LastSeen  string  `json:"last_seen"` // relative, e.g. "2 minutes ago"
+		SysName   *string `json:"sysName"`
+		UpdatedAt *string `json:"updated_at"`
+	}
+
+	// FDBDetailResponse represents a response containing the switch ports a MAC address was learned on.
+	FDBDetailResponse struct {
+		BaseResponse
+		MACAddress string      `json:"mac"`
+		MACVendor  string      `json:"mac_oui"`
+		Entries    []FDBDetail `json:"ports_fdb"`
+	}
+
+	// Link represents a neighbour discovered by a discovery protocol (e.g. LLDP, CDP) on a device port.
+	Link struct {
+		ID int `json:"id"`
+
+		Active         Bool    `json:"active"`
+		LocalDeviceID  int     `json:"local_device_id"`
+		LocalPortID    int     `json:"local_port_id"`
+		Protocol       string  `json:"protocol"` // e.g. lldp, cdp, fdp, amap, xdp
+		RemoteDeviceID int     `json:"remote_device_id"`
+		RemoteHostname string  `json:"remote_hostname"`
+		RemotePlatform *string `json:"remote_platform"`
+		RemotePort     string  `json:"remote_port"`
+		RemotePortID   *int    `json:"remote_port_id"`
+		RemoteVersion  *string `json:"remote_version"`
+	}
+
+	// LinkResponse represents a response containing a list of links.
+	LinkResponse struct {
+		BaseResponse
+		Links []Link `json:"links"`
+	}
+
+	// linkInfoResponse is the internal response structure for a single link, which
+	// uses the key "link" rather than "links". It's normalized into a LinkResponse.
+	linkInfoResponse struct {
+		BaseResponse
+		Link []Link `json:"link"`
+	}
+
+	// Vlan represents a VLAN of a device.
+	Vlan struct {
+		ID       int `json:"vlan_id"`
+		DeviceID int `json:"device_id"`
+
+		Domain *int    `json:"vlan_domain"`
+		MTU    *int    `json:"vlan_mtu"`
+		Name   string  `json:"vlan_name"`
+		Type   *string `json:"vlan_type"`
+		Vlan   int     `json:"vlan_vlan"` // the VLAN number, e.g. 100
+	}
+
+	// VlanResponse represents a response containing a list of VLANs.
+	VlanResponse struct {
+		BaseResponse
+		Vlans []Vlan `json:"vlans"`
+	}
+)
+
+// GetARP retrieves ARP entries matching an IPv4 address, CIDR network (e.g. "10.0.0.0/24")
+// or MAC address.
+//
+// Documentation: https://docs.librenms.org/API/ARP/#list_arp
+func (c *Client) GetARP(query string) (*ARPResponse, error) {
+	return c.GetARPWithContext(context.Background(), query)
+}
+
+// GetARPWithContext is like GetARP, but uses the provided context for the request.
+func (c *Client) GetARPWithContext(ctx context.Context, query string) (*ARPResponse, error) {
+	if query == "" {
+		return nil, errors.New("ARP query is required")
+	}
+	return c.getARP(ctx, query, nil)
+}
+
+// GetDeviceARP retrieves all ARP entries of a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/ARP/#list_arp
+func (c *Client) GetDeviceARP(identifier string) (*ARPResponse, error) {
+	return c.GetDeviceARPWithContext(context.Background(), identifier)
+}
+
+// GetDeviceARPWithContext is like GetDeviceARP, but uses the provided context for the request.
+func (c *Client) GetDeviceARPWithContext(ctx context.Context, identifier string) (*ARPResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+	return c.getARP(ctx, "all", &url.Values{"device": {identifier}})
+}
+
+// GetDeviceLinks retrieves the links (discovery protocol neighbours) of a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Switching/#get_links
+func (c *Client) GetDeviceLinks(identifier string) (*LinkResponse, error) {
+	return c.GetDeviceLinksWithContext(context.Background(), identifier)
+}
+
+// GetDeviceLinksWithContext is like GetDeviceLinks, but uses the provided context for the request.
+func (c *Client) GetDeviceLinksWithContext(ctx context.Context, identifier string) (*LinkResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/links", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(LinkResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetDeviceVlans retrieves the VLANs of a device by its ID or hostname.
+//
+// Documentation: https://docs.librenms.org/API/Switching/#get_vlans
+func (c *Client) GetDeviceVlans(identifier string) (*VlanResponse, error) {
+	return c.GetDeviceVlansWithContext(context.Background(), identifier)
+}
+
+// GetDeviceVlansWithContext is like GetDeviceVlans, but uses the provided context for the request.
+func (c *Client) GetDeviceVlansWithContext(ctx context.Context, identifier string) (*VlanResponse, error) {
+	if identifier == "" {
+		return nil, errors.New("device identifier is required")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/vlans", deviceEndpoint, identifier), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(VlanResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetFDB retrieves the forwarding database entries of all devices for a MAC address,
+// or all entries if mac is empty. The MAC address may use any common notation (see NormalizeMAC).
+//
+// Documentation: https://docs.librenms.org/API/Switching/#list_fdb
+func (c *Client) GetFDB(mac string) (*DeviceFDBResponse, error) {
+	return c.GetFDBWithContext(context.Background(), mac)
+}
+
+// GetFDBWithContext is like GetFDB, but uses the provided context for the request.
+func (c *Client) GetFDBWithContext(ctx context.Context, mac string) (*DeviceFDBResponse, error) {
+	uri := fdbEndpoint
+	if mac != "" {
+		normalized, err := NormalizeMAC(mac)
+		if err != nil {
+			return nil, err
+		}
+		uri = fmt.Sprintf("%s/%s", fdbEndpoint, normalized)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(DeviceFDBResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetFDBDetail retrieves the switch ports a MAC address was learned on, with the device
+// and port names resolved, along with the MAC address vendor.
+//
+// Documentation: https://docs.librenms.org/API/Switching/#list_fdb_detail
+func (c *Client) GetFDBDetail(mac string) (*FDBDetailResponse, error) {
+	return c.GetFDBDetailWithContext(context.Background(), mac)
+}
+
+// GetFDBDetailWithContext is like GetFDBDetail, but uses the provided context for the request.
+func (c *Client) GetFDBDetailWithContext(ctx context.Context, mac string) (*FDBDetailResponse, error) {
+	normalized, err := NormalizeMAC(mac)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/detail", fdbEndpoint, normalized), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(FDBDetailResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetLink retrieves a link by its ID.
+//
+// Documentation: https://docs.librenms.org/API/Switching/#get_link
+func (c *Client) GetLink(linkID int) (*LinkResponse, error) {
+	return c.GetLinkWithContext(context.Background(), linkID)
+}
+
+// GetLinkWithContext is like GetLink, but uses the provided context for the request.
+func (c *Client) GetLinkWithContext(ctx context.Context, linkID int) (*LinkResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%d", linkEndpoint, linkID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	internalResp := new(linkInfoResponse)
+	if err = c.do(req, internalResp); err != nil {
+		return nil, err
+	}
+
+	return &LinkResponse{
+		BaseResponse: BaseResponse{
+			Status:  internalResp.Status,
+			Message: internalResp.Message,
+			Count:   len(internalResp.Link),
+		},
+		Links: internalResp.Link,
+	}, nil
+}
+
+// GetLinks retrieves the links (discovery protocol neighbours) of all devices.
+//
+// Documentation: https://docs.librenms.org/API/Switching/#list_links
+func (c *Client) GetLinks() (*LinkResponse, error) {
+	return c.GetLinksWithContext(context.Background())
+}
+
+// GetLinksWithContext is like GetLinks, but uses the provided context for the request.
+func (c *Client) GetLinksWithContext(ctx context.Context) (*LinkResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, linkEndpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(LinkResponse)
+	return resp, c.do(req, resp)
+}
+
+// GetVlans retrieves the VLANs of all devices.
+//
+// Documentation: https://docs.librenms.org/API/Switching/#list_vlans
+func (c *Client) GetVlans() (*VlanResponse, error) {
+	return c.GetVlansWithContext(context.Background())
+}
+
+// GetVlansWithContext is like GetVlans, but uses the provided context for the request.
+func (c *Client) GetVlansWithContext(ctx context.Context) (*VlanResponse, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, vlanEndpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(VlanResponse)
+	return resp, c.do(req, resp)
+}
+
+// getARP retrieves the ARP entries matching the query.
+func (c *Client) getARP(ctx context.Context, query string, params *url.Values) (*ARPResponse, error) {
+	// the API routes CIDR queries as two segments, arp/{address}/{prefix length}; the slash
+	// isn't escaped, as many web servers reject encoded slashes in the path
+	uri := fmt.Sprintf("%s/%s", arpEndpoint, url.PathEscape(query))
+	if address, prefixLen, ok := strings.Cut(query, "/"); ok {
+		if _, err := strconv.Atoi(prefixLen); err != nil {
+			return nil, fmt.Errorf("invalid CIDR network %q", query)
+		}
+		uri = fmt.Sprintf("%s/%s/%s", arpEndpoint, url.PathEscape(address), prefixLen)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, uri, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ARPResponse)
+	return resp, c.do(req, resp)
+}
+
+// NormalizeMAC converts a MAC address in any common notation (e.g. "00:1A:2b:3c:4d:5e",
+// "00-1a-2b-3c-4d-5e", "001a.2b3c.4d5e") to the 12 lowercase hex digits used by LibreNMS,
+// e.g. "001a2b3c4d5e".
+func NormalizeMAC(mac string) (string, error) {
+	normalized := strings.ToLower(strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.TrimSpace(mac)))
+	if len(normalized) != 12 || strings.Trim(normalized, "0123456789abcdef") != "" {
+		return "", fmt.Errorf("invalid MAC address %q", mac)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,195 @@
+package librenms_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointARPAll      = "/api/v0/resources/ip/arp/all"
+	testEndpointARPNetwork  = "/api/v0/resources/ip/arp/10.0.0.0/24"
+	testEndpointDeviceLinks = "/api/v0/devices/1.1.1.1/links"
+	testEndpointDeviceVlans = "/api/v0/devices/1.1.1.1/vlans"
+	testEndpointFDB         = "/api/v0/resources/fdb"
+	testEndpointFDBByMAC    = "/api/v0/resources/fdb/001a2b3c4d5e"
+	testEndpointFDBDetail   = "/api/v0/resources/fdb/001a2b3c4d5e/detail"
+	testEndpointLink        = "/api/v0/resources/links/10"
+	testEndpointLinks       = "/api/v0/resources/links"
+	testEndpointVlans       = "/api/v0/resources/vlans"
+)
+
+// This init function will register handlers for switching API endpoints.
+func init() {
+	// Registering this endpoint outside of handleEndpoint() to verify the query parameters.
+	mux.HandleFunc(testEndpointARPAll, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("device") != "1.1.1.1" {
+			http.Error(w, `{"status": "error", "message": "unexpected query"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(loadMockResponse("get_arp_200.json"))
+		handleWriteErr(err, w)
+	})
+
+	handleEndpoint(testEndpointARPNetwork, mockResponses{
+		http.MethodGet: loadMockResponse("get_arp_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceLinks, mockResponses{
+		http.MethodGet: loadMockResponse("get_links_200.json"),
+	})
+
+	handleEndpoint(testEndpointDeviceVlans, mockResponses{
+		http.MethodGet: loadMockResponse("get_vlans_200.json"),
+	})
+
+	handleEndpoint(testEndpointFDB, mockResponses{
+		http.MethodGet: loadMockResponse("get_fdb_200.json"),
+	})
+
+	handleEndpoint(testEndpointFDBByMAC, mockResponses{
+		http.MethodGet: loadMockResponse("get_fdb_200.json"),
+	})
+
+	handleEndpoint(testEndpointFDBDetail, mockResponses{
+		http.MethodGet: loadMockResponse("get_fdb_detail_200.json"),
+	})
+
+	handleEndpoint(testEndpointLink, mockResponses{
+		http.MethodGet: loadMockResponse("get_link_200.json"),
+	})
+
+	handleEndpoint(testEndpointLinks, mockResponses{
+		http.MethodGet: loadMockResponse("get_links_200.json"),
+	})
+
+	handleEndpoint(testEndpointVlans, mockResponses{
+		http.MethodGet: loadMockResponse("get_vlans_200.json"),
+	})
+}
+
+func TestClient_GetVlans(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetVlans()
+
+	r.NoError(err, "GetVlans returned an error")
+	r.NotNil(resp, "GetVlans response is nil")
+	r.Len(resp.Vlans, 2, "Expected 2 VLANs")
+	r.Equal(100, resp.Vlans[1].Vlan, "Unexpected VLAN number")
+	r.Equal("users", resp.Vlans[1].Name, "Unexpected VLAN name")
+	r.Nil(resp.Vlans[1].MTU, "Expected nil MTU")
+
+	resp, err = testAPIClient.GetDeviceVlans("1.1.1.1")
+	r.NoError(err, "GetDeviceVlans returned an error")
+	r.Len(resp.Vlans, 2, "Expected 2 VLANs")
+
+	_, err = testAPIClient.GetDeviceVlans("")
+	r.Error(err, "Expected error for empty device identifier")
+}
+
+func TestClient_GetLinks(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetLinks()
+
+	r.NoError(err, "GetLinks returned an error")
+	r.Len(resp.Links, 2, "Expected 2 links")
+
+	link := resp.Links[0]
+	r.True(bool(link.Active), "Expected active link")
+	r.Equal("lldp", link.Protocol, "Unexpected protocol")
+	r.Equal("core1.example.com", link.RemoteHostname, "Unexpected remote hostname")
+	r.Equal(45, *link.RemotePortID, "Unexpected remote port ID")
+	r.Nil(resp.Links[1].RemotePortID, "Expected nil remote port ID")
+
+	resp, err = testAPIClient.GetDeviceLinks("1.1.1.1")
+	r.NoError(err, "GetDeviceLinks returned an error")
+	r.Len(resp.Links, 2, "Expected 2 links")
+
+	_, err = testAPIClient.GetDeviceLinks("")
+	r.Error(err, "Expected error for empty device identifier")
+
+	resp, err = testAPIClient.GetLink(10)
+	r.NoError(err, "GetLink returned an error")
+	r.Equal(1, resp.Count, "Expected count 1")
+	r.Equal(10, resp.Links[0].ID, "Unexpected link ID")
+}
+
+func TestClient_GetFDB(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetFDB("")
+	r.NoError(err, "GetFDB returned an error")
+	r.Len(resp.Entries, 1, "Expected 1 FDB entry")
+
+	resp, err = testAPIClient.GetFDB("00:1A:2B:3C:4D:5E")
+	r.NoError(err, "GetFDB returned an error for a MAC address")
+	r.Equal("001a2b3c4d5e", resp.Entries[0].MACAddress, "Unexpected MAC address")
+	r.Equal(3, resp.Entries[0].PortID, "Unexpected port ID")
+
+	_, err = testAPIClient.GetFDB("not-a-mac")
+	r.Error(err, "Expected error for invalid MAC address")
+}
+
+func TestClient_GetFDBDetail(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetFDBDetail("001a.2b3c.4d5e")
+
+	r.NoError(err, "GetFDBDetail returned an error")
+	r.NotNil(resp, "GetFDBDetail response is nil")
+	r.Equal("Example Networks", resp.MACVendor, "Unexpected MAC vendor")
+	r.Len(resp.Entries, 1, "Expected 1 entry")
+	r.Equal("access1", *resp.Entries[0].SysName, "Unexpected sysName")
+	r.Equal("Gi1/0/3", *resp.Entries[0].IfName, "Unexpected ifName")
+}
+
+func TestClient_GetARP(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	resp, err := testAPIClient.GetARP("10.0.0.0/24")
+
+	r.NoError(err, "GetARP returned an error")
+	r.Len(resp.Entries, 2, "Expected 2 ARP entries")
+	r.Equal("10.0.0.20", resp.Entries[0].IPv4Address, "Unexpected IPv4 address")
+	r.Equal("001a2b3c4d5e", resp.Entries[0].MACAddress, "Unexpected MAC address")
+
+	resp, err = testAPIClient.GetDeviceARP("1.1.1.1")
+	r.NoError(err, "GetDeviceARP returned an error")
+	r.Len(resp.Entries, 2, "Expected 2 ARP entries")
+
+	_, err = testAPIClient.GetARP("")
+	r.Error(err, "Expected error for empty query")
+
+	_, err = testAPIClient.GetARP("10.0.0.0/x")
+	r.Error(err, "Expected error for invalid prefix length")
+}
+
+func TestNormalizeMAC(t *testing.T) {
+	r := require.New(t)
+
+	for _, mac := range []string{"00:1A:2B:3C:4D:5E", "00-1a-2b-3c-4d-5e", "001a.2b3c.4d5e", "001A2B3C4D5E", " 001a2b3c4d5e "} {
+		normalized, err := librenms.NormalizeMAC(mac)
+		r.NoError(err, "NormalizeMAC returned an error for %q", mac)
+		r.Equal("001a2b3c4d5e", normalized, "Unexpected normalized MAC for %q", mac)
+	}
+
+	for _, mac := range []string{"", "00:1a:2b:3c:4d", "00:1a:2b:3c:4d:5g", "00:1a:2b:3c:4d:5e:6f"} {
+		_, err := librenms.NormalizeMAC(mac)
+		r.Error(err, "Expected error for %q", mac)
+	}
+}
@@ -0,0 +1,484 @@
+package librenms
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	syslogSinkEndpoint = "syslogsink"
+
+	// defaultSyslogBatchSize is the default maximum number of messages forwarded per request.
+	defaultSyslogBatchSize = 100
+	// defaultSyslogFlushInterval is the default maximum time a message is buffered before it's forwarded.
+	defaultSyslogFlushInterval = time.Second
+	// defaultSyslogFlushTimeout is the default maximum time a batch of messages takes to be forwarded.
+	defaultSyslogFlushTimeout = 30 * time.Second
+	// maxSyslogMessageSize is the maximum size of a syslog message read by the relay.
+	maxSyslogMessageSize = 64 * 1024
+)
+
+var (
+	// syslogFacilities are the syslog facility names, indexed by facility code.
+	syslogFacilities = []string{
+		"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+		"uucp", "cron", "authpriv", "ftp", "ntp", "security", "console", "solaris-cron",
+		"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+	}
+
+	// syslogSeverities are the syslog severity names, indexed by severity code.
+	syslogSeverities = []string{
+		"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug",
+	}
+)
+
+type (
+	// SyslogSinkMessage represents a syslog message pushed to LibreNMS with SendSyslog().
+	//
+	// Host must match the hostname or IP of a device in LibreNMS for the message to be stored.
+	SyslogSinkMessage struct {
+		Host      string `json:"host"`
+		Facility  string `json:"facility"`  // e.g. "daemon", "local7"
+		Priority  string `json:"priority"`  // severity name, e.g. "info"
+		Level     string `json:"level"`     // severity name, e.g. "info"
+		Tag       string `json:"tag"`       // the PRI value as 2 hex digits, e.g. "1e"
+		Timestamp string `json:"timestamp"` // 'Y-m-d H:i:s'
+		Program   string `json:"program"`
+		Message   string `json:"msg"`
+	}
+
+	// SyslogRelay receives syslog messages over UDP or TCP, parses them (RFC 5424 or RFC 3164)
+	// and forwards them in batches to LibreNMS with SendSyslog().
+	//
+	// Create a relay with NewSyslogRelay() and adjust the exported fields before serving.
+	SyslogRelay struct {
+		// BatchSize is the maximum number of messages forwarded per request.
+		BatchSize int
+		// FlushInterval is the maximum time a message is buffered before it's forwarded.
+		FlushInterval time.Duration
+		// FlushTimeout is the maximum time a batch of messages takes to be forwarded, including
+		// the final flush on shutdown, so an unresponsive LibreNMS can't block the relay.
+		FlushTimeout time.Duration
+		// ErrorHandler is called with parse and forwarding errors. By default, errors are
+		// logged with the client logger.
+		ErrorHandler func(error)
+
+		client *Client
+	}
+
+	// syslogBatcher buffers messages and forwards them in batches.
+	syslogBatcher struct {
+		ctx      context.Context
+		relay    *SyslogRelay
+		messages chan SyslogSinkMessage
+		done     chan struct{}
+	}
+)
+
+// SendSyslog pushes syslog messages into LibreNMS, which processes them like
+// messages received by its own syslog listener.
+//
+// Documentation: https://docs.librenms.org/API/Logs/#syslogsink
+func (c *Client) SendSyslog(messages []SyslogSinkMessage) (*BaseResponse, error) {
+	return c.SendSyslogWithContext(context.Background(), messages)
+}
+
+// SendSyslogWithContext is like SendSyslog, but uses the provided context for the request.
+func (c *Client) SendSyslogWithContext(ctx context.Context, messages []SyslogSinkMessage) (*BaseResponse, error) {
+	if len(messages) == 0 {
+		return nil, errors.New("at least one syslog message is required")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, syslogSinkEndpoint, messages, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BaseResponse)
+	return resp, c.do(req, resp)
+}
+
+// ParseSyslogMessage parses an RFC 5424 or RFC 3164 syslog message into a SyslogSinkMessage.
+//
+// Messages without a hostname or timestamp (allowed by RFC 3164) have those fields left empty;
+// the relay fills them in with the sender address and the time of receipt.
+func ParseSyslogMessage(data []byte) (*SyslogSinkMessage, error) {
+	line := strings.TrimRight(string(data), "\r\n\x00")
+	if !strings.HasPrefix(line, "<") {
+		return nil, fmt.Errorf("invalid syslog message: missing PRI")
+	}
+
+	end := strings.IndexByte(line, '>')
+	if end < 2 || end > 4 {
+		return nil, fmt.Errorf("invalid syslog message: malformed PRI")
+	}
+	pri, err := strconv.Atoi(line[1:end])
+	if err != nil || pri < 0 || pri > 191 {
+		return nil, fmt.Errorf("invalid syslog message: malformed PRI %q", line[1:end])
+	}
+
+	severity := syslogSeverities[pri%8]
+	msg := &SyslogSinkMessage{
+		Facility: syslogFacilities[pri/8],
+		Priority: severity,
+		Level:    severity,
+		Tag:      fmt.Sprintf("%02x", pri),
+	}
+
+	rest := line[end+1:]
+	if strings.HasPrefix(rest, "1 ") {
+		parseRFC5424(msg, rest[2:])
+	} else {
+		parseRFC3164(msg, rest)
+	}
+	return msg, nil
+}
+
+// parseRFC5424 parses the part of an RFC 5424 message following the version:
+// TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func parseRFC5424(msg *SyslogSinkMessage, rest string) {
+	fields := strings.SplitN(rest, " ", 6)
+	for len(fields) < 6 {
+		fields = append(fields, "-")
+	}
+	nilValue := func(s string) string {
+		if s == "-" {
+			return ""
+		}
+		return s
+	}
+
+	if ts, err := time.Parse(time.RFC3339Nano, fields[0]); err == nil {
+		msg.Timestamp = ts.Format(timeLayout)
+	}
+	msg.Host = nilValue(fields[1])
+	msg.Program = nilValue(fields[2])
+
+	// skip the structured data, which is either "-" or one or more [elements]
+	body := fields[5]
+	if strings.HasPrefix(body, "-") {
+		body = strings.TrimPrefix(body[1:], " ")
+	} else {
+		for strings.HasPrefix(body, "[") {
+			idx := structuredDataEnd(body)
+			if idx < 0 {
+				break
+			}
+			body = body[idx+1:]
+		}
+		body = strings.TrimPrefix(body, " ")
+	}
+	msg.Message = strings.TrimPrefix(body, "\ufeff")
+}
+
+// structuredDataEnd returns the index of the closing bracket of the structured data
+// element at the start of s, honoring escaped characters within parameter values.
+func structuredDataEnd(s string) int {
+	inQuotes := false
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			inQuotes = !inQuotes
+		case ']':
+			if !inQuotes {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseRFC3164 parses the part of an RFC 3164 message following the PRI:
+// Mmm dd hh:mm:ss HOSTNAME TAG[PID]: MSG
+func parseRFC3164(msg *SyslogSinkMessage, rest string) {
+	if len(rest) >= 15 {
+		if ts, err := time.Parse(time.Stamp, rest[:15]); err == nil {
+			now := time.Now()
+			ts = ts.AddDate(now.Year(), 0, 0)
+			// a timestamp in the future is from the end of last year
+			if ts.After(now.AddDate(0, 0, 1)) {
+				ts = ts.AddDate(-1, 0, 0)
+			}
+			msg.Timestamp = ts.Format(timeLayout)
+			rest = strings.TrimPrefix(rest[15:], " ")
+
+			if host, remainder, ok := strings.Cut(rest, " "); ok && !strings.HasSuffix(host, ":") {
+				msg.Host = host
+				rest = remainder
+			}
+		}
+	}
+
+	// the tag ends at the first ':' or '[', and must be alphanumeric (with some punctuation)
+	tagEnd := strings.IndexAny(rest, ":[ ")
+	if tagEnd > 0 && tagEnd <= 48 && rest[tagEnd] != ' ' {
+		msg.Program = rest[:tagEnd]
+		if _, after, ok := strings.Cut(rest, ":"); ok {
+			rest = after
+		}
+	}
+	msg.Message = strings.TrimPrefix(rest, " ")
+}
+
+// NewSyslogRelay creates a new SyslogRelay which forwards messages through the given client.
+func NewSyslogRelay(client *Client) *SyslogRelay {
+	return &SyslogRelay{
+		BatchSize:     defaultSyslogBatchSize,
+		FlushInterval: defaultSyslogFlushInterval,
+		FlushTimeout:  defaultSyslogFlushTimeout,
+		client:        client,
+	}
+}
+
+// ListenAndServeUDP listens on the UDP address and relays messages until the context is done.
+func (r *SyslogRelay) ListenAndServeUDP(ctx context.Context, addr string) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	return r.ServeUDP(ctx, conn)
+}
+
+// ListenAndServeTCP listens on the TCP address and relays messages until the context is done.
+func (r *SyslogRelay) ListenAndServeTCP(ctx context.Context, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return r.ServeTCP(ctx, ln)
+}
+
+// ServeUDP relays messages received on the connection until the context is done,
+// then flushes any buffered messages. The connection is closed when ServeUDP returns.
+// Each datagram is expected to contain a single message.
+func (r *SyslogRelay) ServeUDP(ctx context.Context, conn net.PacketConn) error {
+	b := r.newBatcher(ctx)
+	defer b.close()
+
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	buf := make([]byte, maxSyslogMessageSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		r.handleMessage(b, buf[:n], addr)
+	}
+}
+
+// ServeTCP relays messages received on connections accepted by the listener until
+// the context is done or accepting a connection fails, then closes the open connections
+// and flushes any buffered messages. The listener is closed when
+// ServeTCP returns. Messages may be framed with octet counting or newlines (RFC 6587).
+func (r *SyslogRelay) ServeTCP(ctx context.Context, ln net.Listener) error {
+	b := r.newBatcher(ctx)
+	defer b.close()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	// connections are closed when ServeTCP returns, including on Accept errors,
+	// so waiting for them doesn't block on idle peers
+	connCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, func() {
+		_ = ln.Close()
+	})
+	defer stop()
+	defer func() {
+		_ = ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.serveTCPConn(connCtx, b, conn)
+		}()
+	}
+}
+
+// serveTCPConn reads framed messages from a TCP connection until it's closed or the context is done.
+func (r *SyslogRelay) serveTCPConn(ctx context.Context, b *syslogBatcher, conn net.Conn) {
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	reader := bufio.NewReaderSize(conn, maxSyslogMessageSize)
+	for {
+		frame, err := readSyslogFrame(reader)
+		if len(frame) > 0 {
+			r.handleMessage(b, frame, conn.RemoteAddr())
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) && ctx.Err() == nil {
+				r.handleError(fmt.Errorf("failed to read syslog message from %s: %w", conn.RemoteAddr(), err))
+			}
+			return
+		}
+	}
+}
+
+// readSyslogFrame reads a single message, framed by octet counting ("LEN MSG") or a trailing newline.
+func readSyslogFrame(reader *bufio.Reader) ([]byte, error) {
+	first, err := reader.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+
+	if first[0] >= '1' && first[0] <= '9' {
+		lenStr, err := reader.ReadString(' ')
+		if err != nil {
+			return nil, err
+		}
+		length, err := strconv.Atoi(strings.TrimSpace(lenStr))
+		if err != nil || length > maxSyslogMessageSize {
+			return nil, fmt.Errorf("invalid octet count %q", lenStr)
+		}
+		frame := make([]byte, length)
+		_, err = io.ReadFull(reader, frame)
+		return frame, err
+	}
+
+	frame, err := reader.ReadBytes('\n')
+	return bytes.TrimRight(frame, "\r\n"), err
+}
+
+// handleMessage parses a message and queues it for forwarding.
+func (r *SyslogRelay) handleMessage(b *syslogBatcher, data []byte, addr net.Addr) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return
+	}
+
+	msg, err := ParseSyslogMessage(data)
+	if err != nil {
+		r.handleError(fmt.Errorf("failed to parse syslog message from %s: %w", addr, err))
+		return
+	}
+
+	if msg.Host == "" && addr != nil {
+		if host, _, err := net.SplitHostPort(addr.String()); err == nil {
+			msg.Host = host
+		}
+	}
+	if msg.Timestamp == "" {
+		msg.Timestamp = time.Now().Format(timeLayout)
+	}
+	b.messages <- *msg
+}
+
+// handleError passes the error to the ErrorHandler, or logs it.
+func (r *SyslogRelay) handleError(err error) {
+	if r.ErrorHandler != nil {
+		r.ErrorHandler(err)
+		return
+	}
+	r.client.log.LogAttrs(context.Background(), slog.LevelError, "syslog relay error", slog.Any("error", err))
+}
+
+// newBatcher creates and starts a batcher for the relay.
+func (r *SyslogRelay) newBatcher(ctx context.Context) *syslogBatcher {
+	batchSize := r.BatchSize
+	if batchSize < 1 {
+		batchSize = defaultSyslogBatchSize
+	}
+
+	b := &syslogBatcher{
+		// the final flush happens after the context is done, so don't inherit its cancellation;
+		// each flush is bounded by the flush timeout instead
+		ctx:      context.WithoutCancel(ctx),
+		relay:    r,
+		messages: make(chan SyslogSinkMessage, batchSize),
+		done:     make(chan struct{}),
+	}
+	go b.run(batchSize)
+	return b
+}
+
+// run collects messages and forwards them when the batch is full or the flush interval elapses.
+func (b *syslogBatcher) run(batchSize int) {
+	defer close(b.done)
+
+	interval := b.relay.FlushInterval
+	if interval <= 0 {
+		interval = defaultSyslogFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	timeout := b.relay.FlushTimeout
+	if timeout <= 0 {
+		timeout = defaultSyslogFlushTimeout
+	}
+
+	batch := make([]SyslogSinkMessage, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(b.ctx, timeout)
+		defer cancel()
+		if _, err := b.relay.client.SendSyslogWithContext(ctx, batch); err != nil {
+			b.relay.handleError(fmt.Errorf("failed to forward %d syslog messages: %w", len(batch), err))
+		}
+		batch = make([]SyslogSinkMessage, 0, batchSize)
+	}
+
+	for {
+		select {
+		case msg, ok := <-b.messages:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, msg)
+			if len(batch) >= batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// close stops accepting messages, and waits for the buffered messages to be forwarded.
+func (b *syslogBatcher) close() {
+	close(b.messages)
+	<-b.done
+}
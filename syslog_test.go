@@ -0,0 +1,315 @@
+package librenms_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jokelyo/go-librenms"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpointSyslogSink = "/api/v0/syslogsink"
+)
+
+// syslogSinkBatches receives the batches posted to the syslogsink endpoint.
+var syslogSinkBatches = make(chan []librenms.SyslogSinkMessage, 10)
+
+// failingListener accepts a single connection, then fails once fail is closed.
+type failingListener struct {
+	net.Listener
+	accepted bool
+	fail     chan struct{}
+}
+
+func (l *failingListener) Accept() (net.Conn, error) {
+	if !l.accepted {
+		l.accepted = true
+		return l.Listener.Accept()
+	}
+	<-l.fail
+	return nil, errors.New("accept failed")
+}
+
+// This init function will register handlers for syslog-related API endpoints.
+func init() {
+	// Registering this endpoint outside of handleEndpoint() to capture the posted messages.
+	mux.HandleFunc(testEndpointSyslogSink, func(w http.ResponseWriter, r *http.Request) {
+		var batch []librenms.SyslogSinkMessage
+		if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&batch) != nil {
+			http.Error(w, `{"status": "error", "message": "unexpected request"}`, http.StatusBadRequest)
+			return
+		}
+		syslogSinkBatches <- batch
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"status": "ok", "message": "", "count": 0}`))
+		handleWriteErr(err, w)
+	})
+}
+
+func TestParseSyslogMessage_RFC5424(t *testing.T) {
+	r := require.New(t)
+
+	msg, err := librenms.ParseSyslogMessage([]byte(
+		`<165>1 2025-06-01T22:01:02.003Z router1.example.com sshd 1234 ID47 [exampleSDID@32473 iut="3" eventSource="App]"] Accepted publickey for admin` + "\n"))
+
+	r.NoError(err, "ParseSyslogMessage returned an error")
+	r.Equal("router1.example.com", msg.Host, "Unexpected host")
+	r.Equal("local4", msg.Facility, "Unexpected facility")
+	r.Equal("notice", msg.Priority, "Unexpected priority")
+	r.Equal("notice", msg.Level, "Unexpected level")
+	r.Equal("a5", msg.Tag, "Unexpected tag")
+	r.Equal("sshd", msg.Program, "Unexpected program")
+	r.Equal("2025-06-01 22:01:02", msg.Timestamp, "Unexpected timestamp")
+	r.Equal("Accepted publickey for admin", msg.Message, "Unexpected message")
+
+	msg, err = librenms.ParseSyslogMessage([]byte(`<14>1 - - - - - - hello`))
+	r.NoError(err, "ParseSyslogMessage returned an error for nil values")
+	r.Empty(msg.Host, "Expected empty host")
+	r.Empty(msg.Timestamp, "Expected empty timestamp")
+	r.Equal("hello", msg.Message, "Unexpected message")
+}
+
+func TestParseSyslogMessage_RFC3164(t *testing.T) {
+	r := require.New(t)
+
+	msg, err := librenms.ParseSyslogMessage([]byte(`<30>Jun  1 22:01:02 switch1 ntpd[812]: time reset +0.12 s`))
+
+	r.NoError(err, "ParseSyslogMessage returned an error")
+	r.Equal("switch1", msg.Host, "Unexpected host")
+	r.Equal("daemon", msg.Facility, "Unexpected facility")
+	r.Equal("info", msg.Level, "Unexpected level")
+	r.Equal("1e", msg.Tag, "Unexpected tag")
+	r.Equal("ntpd", msg.Program, "Unexpected program")
+	r.Contains(msg.Timestamp, "-06-01 22:01:02", "Unexpected timestamp")
+	r.Equal("time reset +0.12 s", msg.Message, "Unexpected message")
+
+	// no timestamp or hostname
+	msg, err = librenms.ParseSyslogMessage([]byte(`<13>kernel: link down`))
+	r.NoError(err, "ParseSyslogMessage returned an error")
+	r.Empty(msg.Host, "Expected empty host")
+	r.Equal("kernel", msg.Program, "Unexpected program")
+	r.Equal("link down", msg.Message, "Unexpected message")
+
+	for _, invalid := range []string{"", "no pri", "<>x", "<999>x", "<abc>x"} {
+		_, err = librenms.ParseSyslogMessage([]byte(invalid))
+		r.Error(err, "Expected error for %q", invalid)
+	}
+}
+
+func TestClient_SendSyslog(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	messages := []librenms.SyslogSinkMessage{{
+		Host:      "1.1.1.1",
+		Facility:  "daemon",
+		Priority:  "info",
+		Level:     "info",
+		Tag:       "1e",
+		Timestamp: "2025-06-01 22:01:02",
+		Program:   "sshd",
+		Message:   "Accepted publickey for admin",
+	}}
+
+	resp, err := testAPIClient.SendSyslog(messages)
+
+	r.NoError(err, "SendSyslog returned an error")
+	r.NotNil(resp, "SendSyslog response is nil")
+	r.Equal("ok", resp.Status, "Expected status 'ok'")
+	r.Equal(messages, <-syslogSinkBatches, "Unexpected messages posted")
+
+	_, err = testAPIClient.SendSyslog(nil)
+	r.Error(err, "Expected error for empty messages")
+}
+
+func TestSyslogRelay_ServeUDP(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	r.NoError(err, "Failed to listen on UDP")
+
+	relay := librenms.NewSyslogRelay(testAPIClient)
+	relay.BatchSize = 2
+	relay.FlushInterval = time.Hour
+	relay.ErrorHandler = func(err error) { t.Errorf("unexpected relay error: %v", err) }
+
+	ctx, cancel := context.WithCancel(t.Context())
+	served := make(chan error, 1)
+	go func() { served <- relay.ServeUDP(ctx, conn) }()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	r.NoError(err, "Failed to dial relay")
+	defer func() { _ = client.Close() }()
+
+	for _, line := range []string{
+		`<30>Jun  1 22:01:02 switch1 ntpd[812]: time reset`,
+		`<13>kernel: link down`,
+		`<14>1 2025-06-01T22:01:02Z router1 app - - - flushed on shutdown`,
+	} {
+		_, err = client.Write([]byte(line))
+		r.NoError(err, "Failed to write to relay")
+	}
+
+	// the first two messages fill a batch
+	select {
+	case batch := <-syslogSinkBatches:
+		r.Len(batch, 2, "Expected a full batch")
+		r.Equal("switch1", batch[0].Host, "Unexpected host")
+		r.Equal("127.0.0.1", batch[1].Host, "Expected the sender address as host")
+		r.NotEmpty(batch[1].Timestamp, "Expected the time of receipt as timestamp")
+	case <-time.After(5 * time.Second):
+		r.Fail("Timed out waiting for a batch")
+	}
+
+	// the remaining message is flushed on shutdown
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	r.NoError(<-served, "ServeUDP returned an error")
+	select {
+	case batch := <-syslogSinkBatches:
+		r.Len(batch, 1, "Expected the remaining message")
+		r.Equal("router1", batch[0].Host, "Unexpected host")
+	default:
+		r.Fail("Expected the remaining message to be flushed")
+	}
+}
+
+func TestSyslogRelay_ServeTCP(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	r.NoError(err, "Failed to listen on TCP")
+
+	relay := librenms.NewSyslogRelay(testAPIClient)
+	relay.FlushInterval = 10 * time.Millisecond
+	relay.ErrorHandler = func(err error) { t.Errorf("unexpected relay error: %v", err) }
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go func() { _ = relay.ServeTCP(ctx, ln) }()
+
+	client, err := net.Dial("tcp", ln.Addr().String())
+	r.NoError(err, "Failed to dial relay")
+
+	// one newline-delimited and one octet-counted message
+	framed := `<14>1 - host2 app - - - octet counted`
+	_, err = client.Write([]byte("<13>Jun  1 22:01:02 host1 app: newline\n" + "37 " + framed))
+	r.NoError(err, "Failed to write to relay")
+	r.NoError(client.Close(), "Failed to close connection")
+
+	var received []librenms.SyslogSinkMessage
+	timeout := time.After(5 * time.Second)
+	for len(received) < 2 {
+		select {
+		case batch := <-syslogSinkBatches:
+			received = append(received, batch...)
+		case <-timeout:
+			r.FailNow("Timed out waiting for messages")
+		}
+	}
+	r.Equal("newline", received[0].Message, "Unexpected newline-delimited message")
+	r.Equal("octet counted", received[1].Message, "Unexpected octet-counted message")
+	r.Equal("host2", received[1].Host, "Unexpected host")
+}
+
+func TestSyslogRelay_ServeTCP_AcceptError(t *testing.T) {
+	r := require.New(t)
+
+	r.NotNil(testAPIClient, "Global testAPIClient should be initialized")
+
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	r.NoError(err, "Failed to listen on TCP")
+	ln := &failingListener{Listener: tcpListener, fail: make(chan struct{})}
+
+	relay := librenms.NewSyslogRelay(testAPIClient)
+	relay.FlushInterval = time.Hour
+	relay.ErrorHandler = func(err error) { t.Errorf("unexpected relay error: %v", err) }
+
+	served := make(chan error, 1)
+	go func() { served <- relay.ServeTCP(t.Context(), ln) }()
+
+	// the connection stays open, so ServeTCP must close it to return
+	client, err := net.Dial("tcp", tcpListener.Addr().String())
+	r.NoError(err, "Failed to dial relay")
+	defer func() { _ = client.Close() }()
+
+	_, err = client.Write([]byte("<13>Jun  1 22:01:02 host1 app: before failure\n"))
+	r.NoError(err, "Failed to write to relay")
+
+	time.Sleep(50 * time.Millisecond)
+	close(ln.fail)
+
+	select {
+	case err = <-served:
+		r.ErrorContains(err, "accept failed", "Expected the Accept error")
+	case <-time.After(5 * time.Second):
+		r.FailNow("Timed out waiting for ServeTCP to return")
+	}
+
+	select {
+	case batch := <-syslogSinkBatches:
+		r.Len(batch, 1, "Expected the buffered message")
+		r.Equal("before failure", batch[0].Message, "Unexpected message")
+	default:
+		r.Fail("Expected the buffered message to be flushed")
+	}
+}
+
+func TestSyslogRelay_FlushTimeout(t *testing.T) {
+	r := require.New(t)
+
+	// the server doesn't respond until the test ends, so only the flush timeout ends the final flush
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	client, err := librenms.New(srv.URL+"/", "test-token")
+	r.NoError(err, "Failed to create client")
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	r.NoError(err, "Failed to listen on UDP")
+
+	relay := librenms.NewSyslogRelay(client)
+	relay.FlushInterval = time.Hour
+	relay.FlushTimeout = 50 * time.Millisecond
+	relayErrs := make(chan error, 1)
+	relay.ErrorHandler = func(err error) { relayErrs <- err }
+
+	ctx, cancel := context.WithCancel(t.Context())
+	served := make(chan error, 1)
+	go func() { served <- relay.ServeUDP(ctx, conn) }()
+
+	sender, err := net.Dial("udp", conn.LocalAddr().String())
+	r.NoError(err, "Failed to dial relay")
+	defer func() { _ = sender.Close() }()
+
+	_, err = sender.Write([]byte("<13>Jun  1 22:01:02 host1 app: never delivered"))
+	r.NoError(err, "Failed to write to relay")
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case err = <-served:
+		r.NoError(err, "ServeUDP returned an error")
+	case <-time.After(5 * time.Second):
+		r.FailNow("Timed out waiting for ServeUDP to return")
+	}
+	r.ErrorIs(<-relayErrs, context.DeadlineExceeded, "Expected the flush to time out")
+}